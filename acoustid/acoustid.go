@@ -0,0 +1,262 @@
+// Package acoustid identifies audio files with the AcoustID service. It
+// looks up a Chromaprint fingerprint, as computed by fpcalc, and resolves
+// the matching recordings through MusicBrainz.
+package acoustid
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gcottom/musicbrainz"
+)
+
+// LookupEndpoint is the URL of the AcoustID lookup API
+const LookupEndpoint = "https://api.acoustid.org/v2/lookup"
+
+// requestInterval spaces requests to respect the limit of three requests
+// per second set by AcoustID
+const requestInterval = time.Second / 3
+
+// ErrNoMatch is returned by Identify when the fingerprint matches no known
+// recording
+var ErrNoMatch = errors.New("acoustid: no match")
+
+// Error is returned when the AcoustID API reports an error, such as an
+// invalid API key
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("acoustid: error %d: %s", e.Code, e.Message)
+}
+
+// Client is an AcoustID API client. A Client is safe for concurrent use by
+// multiple goroutines.
+type Client struct {
+	apiKey      string
+	httpClient  *http.Client
+	endpoint    string
+	musicbrainz *musicbrainz.Client
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+// Option configures a Client
+type Option func(*Client) error
+
+// NewClient returns a new Client using the application API key registered
+// at acoustid.org
+func NewClient(apiKey string, opts ...Option) (*Client, error) {
+	if apiKey == "" {
+		return nil, errors.New("acoustid: API key required")
+	}
+	c := &Client{
+		apiKey:      apiKey,
+		httpClient:  http.DefaultClient,
+		endpoint:    LookupEndpoint,
+		musicbrainz: musicbrainz.DefaultClient,
+	}
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// WithHTTPClient sets the HTTP client used for AcoustID requests
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) error {
+		if httpClient == nil {
+			return errors.New("acoustid: nil HTTP client")
+		}
+		c.httpClient = httpClient
+		return nil
+	}
+}
+
+// WithEndpoint sets the URL of the lookup API, such as that of a test server
+func WithEndpoint(endpoint string) Option {
+	return func(c *Client) error {
+		if _, err := url.Parse(endpoint); err != nil {
+			return fmt.Errorf("acoustid: invalid endpoint: %w", err)
+		}
+		c.endpoint = endpoint
+		return nil
+	}
+}
+
+// WithMusicBrainzClient sets the MusicBrainz client Identify resolves
+// recordings with, instead of musicbrainz.DefaultClient
+func WithMusicBrainzClient(client *musicbrainz.Client) Option {
+	return func(c *Client) error {
+		if client == nil {
+			return errors.New("acoustid: nil MusicBrainz client")
+		}
+		c.musicbrainz = client
+		return nil
+	}
+}
+
+// Result is a track known to AcoustID that matches a fingerprint
+type Result struct {
+	// ID is the AcoustID track ID
+	ID string
+	// Score ranges from 0 to 1, 1 being a perfect match
+	Score float64
+	// RecordingIDs are the MusicBrainz recordings linked to the track
+	RecordingIDs []musicbrainz.MBID
+}
+
+// Match is a MusicBrainz recording identified by a fingerprint
+type Match struct {
+	Recording musicbrainz.Recording
+	// Score is that of the best AcoustID track linked to the recording
+	Score float64
+	// AcoustID is the ID of that track
+	AcoustID string
+}
+
+// Lookup returns the AcoustID tracks matching a fingerprint of audio
+// lasting duration, best match first
+func (c *Client) Lookup(ctx context.Context, fingerprint string, duration time.Duration) ([]Result, error) {
+	form := url.Values{}
+	form.Set("client", c.apiKey)
+	form.Set("format", "json")
+	form.Set("meta", "recordingids")
+	form.Set("duration", strconv.Itoa(int(duration.Round(time.Second)/time.Second)))
+	form.Set("fingerprint", fingerprint)
+
+	if err := c.wait(ctx); err != nil {
+		return nil, err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var body struct {
+		Status  string `json:"status"`
+		Error   *Error `json:"error"`
+		Results []struct {
+			ID         string  `json:"id"`
+			Score      float64 `json:"score"`
+			Recordings []struct {
+				ID musicbrainz.MBID `json:"id"`
+			} `json:"recordings"`
+		} `json:"results"`
+	}
+	if err := json.NewDecoder(io.LimitReader(response.Body, 8<<20)).Decode(&body); err != nil {
+		return nil, fmt.Errorf("acoustid: %s: %w", response.Status, err)
+	}
+	if body.Status != "ok" {
+		if body.Error != nil {
+			return nil, body.Error
+		}
+		return nil, fmt.Errorf("acoustid: %s", response.Status)
+	}
+
+	results := make([]Result, len(body.Results))
+	for i, r := range body.Results {
+		results[i] = Result{ID: r.ID, Score: r.Score}
+		for _, recording := range r.Recordings {
+			results[i].RecordingIDs = append(results[i].RecordingIDs, recording.ID)
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool { return results[i].Score > results[j].Score })
+	return results, nil
+}
+
+// Identify looks up a fingerprint and retrieves the matching recordings from
+// MusicBrainz, best match first. opts are passed on to the recording
+// lookups, such as WithIncludes("artist-credits", "releases"). Recordings
+// that no longer exist in MusicBrainz are left out. It returns ErrNoMatch if
+// no recording matches.
+func (c *Client) Identify(ctx context.Context, fingerprint string, duration time.Duration, opts ...musicbrainz.RequestOption) ([]Match, error) {
+	results, err := c.Lookup(ctx, fingerprint, duration)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []musicbrainz.MBID
+	best := make(map[musicbrainz.MBID]Result)
+	for _, result := range results {
+		for _, id := range result.RecordingIDs {
+			if _, ok := best[id]; !ok {
+				best[id] = result
+				ids = append(ids, id)
+			}
+		}
+	}
+	if len(ids) == 0 {
+		return nil, ErrNoMatch
+	}
+
+	batch := c.musicbrainz.GetRecordingsByIDs(ctx, ids, opts...)
+	for _, err := range batch.Errors {
+		if !errors.Is(err, musicbrainz.ErrNotFound) {
+			return nil, batch.Err()
+		}
+	}
+
+	var matches []Match
+	seen := make(map[musicbrainz.MBID]bool)
+	for _, id := range ids {
+		recording, ok := batch.Results[id]
+		// Recordings merged together resolve to the same entity
+		if !ok || seen[recording.ID] {
+			continue
+		}
+		seen[recording.ID] = true
+		matches = append(matches, Match{Recording: *recording, Score: best[id].Score, AcoustID: best[id].ID})
+	}
+	if len(matches) == 0 {
+		return nil, ErrNoMatch
+	}
+	return matches, nil
+}
+
+// wait blocks until the next request is allowed by the rate limit or ctx is
+// done
+func (c *Client) wait(ctx context.Context) error {
+	c.mu.Lock()
+	now := time.Now()
+	start := c.next
+	if start.Before(now) {
+		start = now
+	}
+	c.next = start.Add(requestInterval)
+	c.mu.Unlock()
+
+	delay := time.Until(start)
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,85 @@
+package musicbrainz
+
+import "strings"
+
+// Alias represents an alternative name of an entity, such as a translated or
+// transliterated artist name, requested with the "aliases" include
+type Alias struct {
+	Name     string      `json:"name"`
+	SortName string      `json:"sort-name"`
+	Type     string      `json:"type"`
+	Locale   string      `json:"locale"`
+	Primary  bool        `json:"primary"`
+	Begin    PartialDate `json:"begin"`
+	End      PartialDate `json:"end"`
+	Ended    bool        `json:"ended"`
+}
+
+// Aliases is the list of aliases of an entity
+type Aliases []Alias
+
+// ForLocale returns the alias that best matches locale, such as "en" or
+// "ja_JP". An alias for the exact locale is preferred over one in the same
+// language, and a primary alias over other aliases of that locale. Aliases
+// that have ended and search hints rank last. It reports false if no alias
+// is in the language of locale.
+//
+// For a Japanese artist, ForLocale("en") usually returns the name written in
+// Latin script.
+func (a Aliases) ForLocale(locale string) (Alias, bool) {
+	want := normalizeLocale(locale)
+	if want == "" {
+		return Alias{}, false
+	}
+	var best Alias
+	bestScore := 0
+	for _, alias := range a {
+		have := normalizeLocale(alias.Locale)
+		score := 0
+		switch {
+		case have == "":
+			continue
+		case have == want:
+			score = 16
+		case localeLanguage(have) == localeLanguage(want):
+			score = 8
+		default:
+			continue
+		}
+		if alias.Primary {
+			score += 4
+		}
+		if !alias.Ended && alias.End.IsZero() {
+			score += 2
+		}
+		if alias.Type != "Search hint" {
+			score++
+		}
+		if score > bestScore {
+			best, bestScore = alias, score
+		}
+	}
+	return best, bestScore > 0
+}
+
+// normalizeLocale lowercases locale and uses underscores between its parts,
+// as MusicBrainz does
+func normalizeLocale(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "-", "_"))
+}
+
+// localeLanguage returns the language part of a normalized locale
+func localeLanguage(locale string) string {
+	language, _, _ := strings.Cut(locale, "_")
+	return language
+}
+
+// LocalizedName returns the name of the artist in locale, falling back to its
+// main name if it has no alias in that language. Aliases must have been
+// requested with the "aliases" include.
+func (a Artist) LocalizedName(locale string) string {
+	if alias, ok := a.Aliases.ForLocale(locale); ok {
+		return alias.Name
+	}
+	return a.Name
+}
@@ -0,0 +1,69 @@
+package musicbrainz
+
+import (
+	"context"
+	"time"
+)
+
+// Area represents a geographic area in the MusicBrainz database, such as a
+// country, a subdivision or a city
+type Area struct {
+	ID            MBID       `json:"id"`
+	RequestedID   MBID       `json:"-"`
+	Name          string     `json:"name"`
+	SortName      string     `json:"sort-name"`
+	Type          string     `json:"type"`
+	ISO31661Codes []string   `json:"iso-3166-1-codes"`
+	ISO31662Codes []string   `json:"iso-3166-2-codes"`
+	ISO31663Codes []string   `json:"iso-3166-3-codes"`
+	LifeSpan      LifeSpan   `json:"life-span"`
+	Disambig      string     `json:"disambiguation"`
+	Relations     []Relation `json:"relations"`
+	Tags          []Tag      `json:"tags"`
+	Genres        []Genre    `json:"genres"`
+	UserTags      []Tag      `json:"user-tags"`
+	UserGenres    []Genre    `json:"user-genres"`
+	Score         int        `json:"score"`
+}
+
+// AreaSearchResult is a page of areas returned by a search
+type AreaSearchResult struct {
+	Count   int       `json:"count"`
+	Offset  int       `json:"offset"`
+	Created time.Time `json:"created"`
+	Areas   []Area    `json:"areas"`
+}
+
+// SearchAreas searches for areas by their name. Use WithOffset to page through
+// the results.
+func (c *Client) SearchAreas(ctx context.Context, name string, limit int, opts ...RequestOption) (*AreaSearchResult, error) {
+	var result AreaSearchResult
+	if err := c.search(ctx, "area", name, limit, opts, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// SearchAllAreas returns a Pager over the areas matching a search, stopping
+// after maxResults results. A maxResults of zero pages through every result.
+func (c *Client) SearchAllAreas(ctx context.Context, name string, maxResults int, opts ...RequestOption) *Pager[Area] {
+	return searchPager(ctx, maxResults, func(ctx context.Context, limit, offset int) ([]Area, int, error) {
+		result, err := c.SearchAreas(ctx, name, limit, withOffset(opts, offset)...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.Areas, result.Count, nil
+	})
+}
+
+// GetAreaByID retrieves an area by its ID
+func (c *Client) GetAreaByID(ctx context.Context, id MBID, opts ...RequestOption) (*Area, error) {
+	var area Area
+	if err := c.lookup(ctx, "area", id, opts, &area); err != nil {
+		return nil, err
+	}
+	area.RequestedID = id
+
+	return &area, nil
+}
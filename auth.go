@@ -0,0 +1,230 @@
+package musicbrainz
+
+import (
+	"crypto/md5"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// WithCredentials authenticates requests to the MusicBrainz API with the
+// username and password of a MusicBrainz account, using HTTP digest
+// authentication. Submissions and requests for private user data, such as
+// collections, require authentication. Credentials are only sent to the host
+// of the base URL.
+func WithCredentials(username, password string) Option {
+	return func(c *Client) error {
+		if username == "" {
+			return errors.New("musicbrainz: credentials require a username")
+		}
+		c.username, c.password = username, password
+		return nil
+	}
+}
+
+// authenticate wraps the transport of the HTTP client of c to authenticate
+// requests with its credentials or token source. The HTTP client given to
+// WithHTTPClient is copied rather than modified.
+func (c *Client) authenticate() error {
+	u, err := url.Parse(c.baseURL)
+	if err != nil {
+		return err
+	}
+	base := c.httpClient.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	httpClient := *c.httpClient
+	if c.tokenSource != nil {
+		httpClient.Transport = &bearerTransport{base: base, host: u.Host, source: c.tokenSource}
+	} else {
+		httpClient.Transport = &digestTransport{base: base, host: u.Host, username: c.username, password: c.password}
+	}
+	c.httpClient = &httpClient
+	return nil
+}
+
+// digestTransport is an http.RoundTripper answering the HTTP digest
+// authentication challenges of a host. Once challenged, it authenticates
+// further requests to the host up front, saving a round trip.
+type digestTransport struct {
+	base     http.RoundTripper
+	host     string
+	username string
+	password string
+
+	mu        sync.Mutex
+	challenge *digestChallenge
+	count     int
+}
+
+// digestChallenge holds the parameters of a WWW-Authenticate header
+type digestChallenge struct {
+	realm     string
+	nonce     string
+	opaque    string
+	algorithm string
+	qop       string
+}
+
+func (t *digestTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	if request.URL.Host != t.host {
+		return t.base.RoundTrip(request)
+	}
+
+	authenticated, err := t.authorize(request)
+	if err != nil {
+		return nil, err
+	}
+	response, err := t.base.RoundTrip(authenticated)
+	if err != nil || response.StatusCode != http.StatusUnauthorized {
+		return response, err
+	}
+
+	// Answer the challenge, which is either the first one or replaces an
+	// expired nonce, unless the body of the request can't be sent again
+	challenge, ok := parseDigestChallenge(response.Header.Get("WWW-Authenticate"))
+	if !ok || request.Body != nil && request.GetBody == nil {
+		return response, nil
+	}
+	io.Copy(io.Discard, io.LimitReader(response.Body, 64<<10))
+	response.Body.Close()
+
+	t.mu.Lock()
+	t.challenge, t.count = challenge, 0
+	t.mu.Unlock()
+	authenticated, err = t.authorize(request)
+	if err != nil {
+		return nil, err
+	}
+	return t.base.RoundTrip(authenticated)
+}
+
+// authorize returns a copy of request carrying an Authorization header
+// answering the current challenge, or request itself if there is none
+func (t *digestTransport) authorize(request *http.Request) (*http.Request, error) {
+	t.mu.Lock()
+	challenge := t.challenge
+	t.count++
+	count := t.count
+	t.mu.Unlock()
+	if challenge == nil {
+		return request, nil
+	}
+
+	authenticated := request.Clone(request.Context())
+	if request.GetBody != nil {
+		body, err := request.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		authenticated.Body = body
+	}
+	authorization, err := challenge.authorization(t.username, t.password, request.Method, request.URL.RequestURI(), count)
+	if err != nil {
+		return nil, err
+	}
+	authenticated.Header.Set("Authorization", authorization)
+	return authenticated, nil
+}
+
+// authorization returns the Authorization header answering the challenge
+// for a request, as described in RFC 2617
+func (c *digestChallenge) authorization(username, password, method, uri string, count int) (string, error) {
+	if c.algorithm != "" && !strings.EqualFold(c.algorithm, "MD5") {
+		return "", fmt.Errorf("musicbrainz: unsupported digest algorithm %q", c.algorithm)
+	}
+	ha1 := md5Hex(username + ":" + c.realm + ":" + password)
+	ha2 := md5Hex(method + ":" + uri)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `Digest username="%s", realm="%s", nonce="%s", uri="%s"`, username, c.realm, c.nonce, uri)
+	if c.qop == "" {
+		fmt.Fprintf(&b, `, response="%s"`, md5Hex(ha1+":"+c.nonce+":"+ha2))
+	} else {
+		var random [8]byte
+		if _, err := rand.Read(random[:]); err != nil {
+			return "", err
+		}
+		cnonce := hex.EncodeToString(random[:])
+		nc := fmt.Sprintf("%08x", count)
+		response := md5Hex(ha1 + ":" + c.nonce + ":" + nc + ":" + cnonce + ":auth:" + ha2)
+		fmt.Fprintf(&b, `, qop=auth, nc=%s, cnonce="%s", response="%s"`, nc, cnonce, response)
+	}
+	if c.opaque != "" {
+		fmt.Fprintf(&b, `, opaque="%s"`, c.opaque)
+	}
+	if c.algorithm != "" {
+		fmt.Fprintf(&b, `, algorithm=%s`, c.algorithm)
+	}
+	return b.String(), nil
+}
+
+// parseDigestChallenge parses a WWW-Authenticate header offering digest
+// authentication with the "auth" quality of protection or none
+func parseDigestChallenge(header string) (*digestChallenge, bool) {
+	scheme, params, ok := strings.Cut(strings.TrimSpace(header), " ")
+	if !ok || !strings.EqualFold(scheme, "Digest") {
+		return nil, false
+	}
+
+	var challenge digestChallenge
+	for _, param := range splitAuthParams(params) {
+		key, value, _ := strings.Cut(param, "=")
+		value = strings.Trim(strings.TrimSpace(value), `"`)
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "realm":
+			challenge.realm = value
+		case "nonce":
+			challenge.nonce = value
+		case "opaque":
+			challenge.opaque = value
+		case "algorithm":
+			challenge.algorithm = value
+		case "qop":
+			for _, qop := range strings.Split(value, ",") {
+				if strings.TrimSpace(qop) == "auth" {
+					challenge.qop = "auth"
+				}
+			}
+			if challenge.qop == "" {
+				return nil, false
+			}
+		}
+	}
+	if challenge.nonce == "" {
+		return nil, false
+	}
+	return &challenge, true
+}
+
+// splitAuthParams splits the comma-separated parameters of an
+// authentication header, ignoring commas within quoted values
+func splitAuthParams(params string) []string {
+	var parts []string
+	quoted := false
+	start := 0
+	for i := 0; i < len(params); i++ {
+		switch params[i] {
+		case '"':
+			quoted = !quoted
+		case ',':
+			if !quoted {
+				parts = append(parts, params[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, params[start:])
+}
+
+func md5Hex(s string) string {
+	sum := md5.Sum([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
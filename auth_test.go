@@ -0,0 +1,195 @@
+package musicbrainz
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestParseDigestChallenge(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   *digestChallenge
+	}{
+		{
+			"musicbrainz",
+			`Digest realm="musicbrainz.org", nonce="a1b2c3", qop="auth", algorithm=MD5, opaque="xyz"`,
+			&digestChallenge{realm: "musicbrainz.org", nonce: "a1b2c3", opaque: "xyz", algorithm: "MD5", qop: "auth"},
+		},
+		{
+			"comma in quoted value",
+			`Digest realm="a, b", nonce="n,1"`,
+			&digestChallenge{realm: "a, b", nonce: "n,1"},
+		},
+		{
+			"qop list",
+			`digest REALM="r", Nonce="n", qop="auth-int, auth"`,
+			&digestChallenge{realm: "r", nonce: "n", qop: "auth"},
+		},
+		{"auth-int only", `Digest realm="r", nonce="n", qop="auth-int"`, nil},
+		{"no nonce", `Digest realm="r"`, nil},
+		{"basic", `Basic realm="r"`, nil},
+		{"empty", ``, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseDigestChallenge(tt.header)
+			if ok != (tt.want != nil) {
+				t.Fatalf("parseDigestChallenge(%q) ok = %v", tt.header, ok)
+			}
+			if ok && *got != *tt.want {
+				t.Errorf("parseDigestChallenge(%q) = %+v, want %+v", tt.header, *got, *tt.want)
+			}
+		})
+	}
+}
+
+// digestServer is an HTTP server checking digest authentication, issuing a
+// new nonce after every few requests
+type digestServer struct {
+	*httptest.Server
+	username, password string
+	// nonceUses is the number of requests a nonce authenticates
+	nonceUses int
+
+	mu     sync.Mutex
+	nonces int
+	uses   int
+	// counts records the nc parameter of the authenticated requests
+	counts []string
+}
+
+func newDigestServer(t *testing.T, nonceUses int) *digestServer {
+	t.Helper()
+	s := &digestServer{username: "user", password: "secret", nonceUses: nonceUses, nonces: 1}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *digestServer) nonce() string {
+	return fmt.Sprintf("nonce-%d", s.nonces)
+}
+
+func (s *digestServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	params, ok := s.parseAuthorization(r.Header.Get("Authorization"))
+	switch {
+	case !ok:
+		s.challenge(w, false)
+	case params["nonce"] != s.nonce():
+		s.challenge(w, true)
+	case params["response"] != s.response(r, params):
+		w.WriteHeader(http.StatusUnauthorized)
+	default:
+		s.counts = append(s.counts, params["nc"])
+		if s.uses++; s.uses == s.nonceUses {
+			s.nonces++
+			s.uses = 0
+		}
+		w.Write([]byte(`{"id":"5b11f4ce-a62d-471e-81fc-a69a8278c7da","name":"Nirvana"}`))
+	}
+}
+
+func (s *digestServer) challenge(w http.ResponseWriter, stale bool) {
+	header := fmt.Sprintf(`Digest realm="musicbrainz.org", nonce="%s", qop="auth", algorithm=MD5, opaque="opaque, value"`, s.nonce())
+	if stale {
+		header += ", stale=true"
+	}
+	w.Header().Set("WWW-Authenticate", header)
+	w.WriteHeader(http.StatusUnauthorized)
+}
+
+func (s *digestServer) parseAuthorization(header string) (map[string]string, bool) {
+	scheme, rest, ok := strings.Cut(header, " ")
+	if !ok || scheme != "Digest" {
+		return nil, false
+	}
+	params := make(map[string]string)
+	for _, param := range splitAuthParams(rest) {
+		key, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+		params[key] = strings.Trim(value, `"`)
+	}
+	return params, params["username"] == s.username && params["opaque"] == "opaque, value"
+}
+
+func (s *digestServer) response(r *http.Request, params map[string]string) string {
+	ha1 := md5Hex(s.username + ":musicbrainz.org:" + s.password)
+	ha2 := md5Hex(r.Method + ":" + r.URL.RequestURI())
+	return md5Hex(ha1 + ":" + params["nonce"] + ":" + params["nc"] + ":" + params["cnonce"] + ":" + params["qop"] + ":" + ha2)
+}
+
+func TestDigestAuthentication(t *testing.T) {
+	server := newDigestServer(t, 3)
+	c, err := NewClient(WithBaseURL(server.URL), WithoutRateLimit(), WithRetry(1, 0, 0), WithCredentials(server.username, server.password))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 5; i++ {
+		artist, err := c.GetArtistByID(context.Background(), "5b11f4ce-a62d-471e-81fc-a69a8278c7da", WithIncludes("user-tags"))
+		if err != nil {
+			t.Fatalf("request %d: %v", i, err)
+		}
+		if artist.Name != "Nirvana" {
+			t.Fatalf("request %d: got artist %+v", i, artist)
+		}
+	}
+
+	// The nonce count increments with each request and starts over with the
+	// nonce replacing the stale one
+	want := []string{"00000001", "00000002", "00000003", "00000001", "00000002"}
+	if strings.Join(server.counts, " ") != strings.Join(want, " ") {
+		t.Errorf("got nonce counts %v, want %v", server.counts, want)
+	}
+}
+
+func TestDigestWrongPassword(t *testing.T) {
+	server := newDigestServer(t, 3)
+	c, err := NewClient(WithBaseURL(server.URL), WithoutRateLimit(), WithRetry(1, 0, 0), WithCredentials(server.username, "wrong"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = c.GetArtistByID(context.Background(), "5b11f4ce-a62d-471e-81fc-a69a8278c7da", WithIncludes("user-tags"))
+	if err == nil {
+		t.Fatal("request with a wrong password succeeded")
+	}
+	if len(server.counts) != 0 {
+		t.Errorf("got %d authenticated requests", len(server.counts))
+	}
+}
+
+func TestDigestOtherHost(t *testing.T) {
+	var authorization string
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer other.Close()
+
+	server := newDigestServer(t, 3)
+	c, err := NewClient(WithBaseURL(server.URL), WithoutRateLimit(), WithRetry(1, 0, 0), WithCredentials(server.username, server.password))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetArtistByID(context.Background(), "5b11f4ce-a62d-471e-81fc-a69a8278c7da"); err != nil {
+		t.Fatal(err)
+	}
+
+	response, err := c.httpClient.Get(other.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+	if authorization != "" {
+		t.Errorf("credentials sent to another host: %q", authorization)
+	}
+}
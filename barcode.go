@@ -0,0 +1,56 @@
+package musicbrainz
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// ValidBarcode reports whether barcode is a UPC or EAN barcode, of 8, 12, 13
+// or 14 digits, with a correct check digit
+func ValidBarcode(barcode string) bool {
+	switch len(barcode) {
+	case 8, 12, 13, 14:
+	default:
+		return false
+	}
+	sum := 0
+	for i := 0; i < len(barcode); i++ {
+		c := barcode[i]
+		if c < '0' || c > '9' {
+			return false
+		}
+		digit := int(c - '0')
+		// Weights alternate between 3 and 1 from the right, the check
+		// digit having a weight of 1
+		if (len(barcode)-i)%2 == 0 {
+			digit *= 3
+		}
+		sum += digit
+	}
+	return sum%10 == 0
+}
+
+// SubmitBarcodes sets the barcodes of releases, in a single request made as
+// the authenticated user. Barcodes must be valid UPC or EAN barcodes.
+func (c *Client) SubmitBarcodes(ctx context.Context, barcodes map[MBID]string) error {
+	ids := make([]MBID, 0, len(barcodes))
+	for id := range barcodes {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	var s submission
+	for _, id := range ids {
+		barcode := barcodes[id]
+		if !ValidBarcode(barcode) {
+			return fmt.Errorf("musicbrainz: invalid barcode %q for release %s", barcode, id)
+		}
+		entity, err := s.entity("release", id, []string{"release"})
+		if err != nil {
+			return err
+		}
+		entity.Barcode = barcode
+	}
+	return c.submit(ctx, "release", &s)
+}
@@ -0,0 +1,113 @@
+package musicbrainz
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+)
+
+// defaultBatchConcurrency is the number of lookups a batch runs at once,
+// unless set with WithConcurrency. All of them share the rate limit of the
+// Client, so more workers mostly hide network latency.
+const defaultBatchConcurrency = 4
+
+// BatchResult holds the outcome of a batch lookup. Results and Errors are
+// keyed by the requested MBIDs; an MBID merged into another entity maps to
+// that entity.
+type BatchResult[T any] struct {
+	Results map[MBID]*T
+	Errors  map[MBID]error
+}
+
+// Err returns the errors of the failed lookups joined together, or nil if
+// every lookup succeeded
+func (r *BatchResult[T]) Err() error {
+	if len(r.Errors) == 0 {
+		return nil
+	}
+	ids := make([]MBID, 0, len(r.Errors))
+	for id := range r.Errors {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	errs := make([]error, len(ids))
+	for i, id := range ids {
+		errs[i] = r.Errors[id]
+	}
+	return errors.Join(errs...)
+}
+
+// batchLookup calls get for every distinct MBID of ids from a bounded number
+// of goroutines. IDs not looked up before ctx is done fail with its error.
+func batchLookup[T any](ctx context.Context, ids []MBID, opts []RequestOption, get func(context.Context, MBID, ...RequestOption) (*T, error)) *BatchResult[T] {
+	result := &BatchResult[T]{Results: make(map[MBID]*T), Errors: make(map[MBID]error)}
+	seen := make(map[MBID]bool, len(ids))
+	queue := make(chan MBID, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			queue <- id
+		}
+	}
+	close(queue)
+
+	workers := collectOptions(opts).concurrency
+	if workers <= 0 {
+		workers = defaultBatchConcurrency
+	}
+	if workers > len(seen) {
+		workers = len(seen)
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range queue {
+				var entity *T
+				err := ctx.Err()
+				if err == nil {
+					entity, err = get(ctx, id, opts...)
+				}
+				mu.Lock()
+				if err != nil {
+					result.Errors[id] = err
+				} else {
+					result.Results[id] = entity
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	return result
+}
+
+// GetArtistsByIDs looks up several artists concurrently, skipping duplicate
+// IDs. Pass WithConcurrency to set the number of concurrent lookups; all of
+// them respect the rate limit of the Client. The RequestOptions apply to
+// every lookup.
+func (c *Client) GetArtistsByIDs(ctx context.Context, ids []MBID, opts ...RequestOption) *BatchResult[Artist] {
+	return batchLookup(ctx, ids, opts, c.GetArtistByID)
+}
+
+// GetReleasesByIDs looks up several releases concurrently, like
+// GetArtistsByIDs
+func (c *Client) GetReleasesByIDs(ctx context.Context, ids []MBID, opts ...RequestOption) *BatchResult[Release] {
+	return batchLookup(ctx, ids, opts, c.GetReleaseByID)
+}
+
+// GetRecordingsByIDs looks up several recordings concurrently, like
+// GetArtistsByIDs
+func (c *Client) GetRecordingsByIDs(ctx context.Context, ids []MBID, opts ...RequestOption) *BatchResult[Recording] {
+	return batchLookup(ctx, ids, opts, c.GetRecordingByID)
+}
+
+// GetReleaseGroupsByIDs looks up several release groups concurrently, like
+// GetArtistsByIDs
+func (c *Client) GetReleaseGroupsByIDs(ctx context.Context, ids []MBID, opts ...RequestOption) *BatchResult[ReleaseGroup] {
+	return batchLookup(ctx, ids, opts, c.GetReleaseGroupByID)
+}
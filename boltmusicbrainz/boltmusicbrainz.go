@@ -0,0 +1,120 @@
+// Package boltmusicbrainz provides a musicbrainz.Cache stored in a BoltDB
+// file, so that long-running taggers and batch jobs survive restarts
+// without fetching every entity again. It is a module of its own so that
+// the musicbrainz module doesn't depend on BoltDB.
+package boltmusicbrainz
+
+import (
+	"encoding/binary"
+	"errors"
+	"time"
+
+	"github.com/gcottom/musicbrainz"
+	bolt "go.etcd.io/bbolt"
+)
+
+// bucket holds the cached values, each prefixed with its expiry in Unix
+// nanoseconds, or zero if it doesn't expire
+var bucket = []byte("musicbrainz")
+
+// Cache is a musicbrainz.Cache storing values in a BoltDB file. It is safe
+// for concurrent use, but BoltDB locks the file, so that a single process
+// can open it at a time; use musicbrainz.DiskCache to share a cache between
+// processes.
+type Cache struct {
+	db *bolt.DB
+}
+
+var _ musicbrainz.Cache = (*Cache)(nil)
+
+// Open opens the cache stored in the file at path, creating it if needed.
+// It fails after timeout if another process holds the file, or waits
+// indefinitely if timeout is zero.
+func Open(path string, timeout time.Duration) (*Cache, error) {
+	if path == "" {
+		return nil, errors.New("boltmusicbrainz: empty cache path")
+	}
+	db, err := bolt.Open(path, 0o644, &bolt.Options{Timeout: timeout})
+	if err != nil {
+		return nil, err
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(bucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &Cache{db: db}, nil
+}
+
+// Close closes the file of the cache
+func (c *Cache) Close() error {
+	return c.db.Close()
+}
+
+// Get returns the value stored for key, if any and not expired
+func (c *Cache) Get(key string) ([]byte, bool) {
+	var value []byte
+	c.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(bucket).Get([]byte(key))
+		if len(data) < 8 || expired(data) {
+			return nil
+		}
+		// Values are only valid within the transaction
+		value = append([]byte{}, data[8:]...)
+		return nil
+	})
+	return value, value != nil
+}
+
+// Set stores value for key
+func (c *Cache) Set(key string, value []byte, ttl time.Duration) {
+	var expires int64
+	if ttl > 0 {
+		expires = time.Now().Add(ttl).UnixNano()
+	}
+	data := make([]byte, 8, 8+len(value))
+	binary.BigEndian.PutUint64(data, uint64(expires))
+	data = append(data, value...)
+
+	c.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucket).Put([]byte(key), data)
+	})
+}
+
+// Delete removes the value stored for key, if any
+func (c *Cache) Delete(key string) {
+	c.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucket).Delete([]byte(key))
+	})
+}
+
+// Prune removes the expired values. Get ignores expired values but leaves
+// them in the file until then.
+func (c *Cache) Prune() error {
+	return c.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(bucket)
+		var keys [][]byte
+		b.ForEach(func(key, data []byte) error {
+			if len(data) < 8 || expired(data) {
+				keys = append(keys, append([]byte{}, key...))
+			}
+			return nil
+		})
+		// Keys can't be deleted while iterating over the bucket
+		for _, key := range keys {
+			if err := b.Delete(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// expired reports whether data holds an expired value
+func expired(data []byte) bool {
+	expires := int64(binary.BigEndian.Uint64(data))
+	return expires != 0 && time.Now().UnixNano() > expires
+}
@@ -0,0 +1,92 @@
+package boltmusicbrainz
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gcottom/musicbrainz"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestCache(t *testing.T) {
+	c, err := Open(filepath.Join(t.TempDir(), "cache.db"), time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	c.Set("kept", []byte("value"), 0)
+	c.Set("empty", []byte{}, time.Hour)
+	c.Set("expired", []byte("value"), time.Nanosecond)
+	time.Sleep(time.Millisecond)
+
+	if value, ok := c.Get("kept"); !ok || string(value) != "value" {
+		t.Errorf("Get(kept) = %q, %v", value, ok)
+	}
+	if value, ok := c.Get("empty"); !ok || len(value) != 0 {
+		t.Errorf("Get(empty) = %q, %v", value, ok)
+	}
+	if _, ok := c.Get("expired"); ok {
+		t.Error("Get(expired) found the value")
+	}
+	if _, ok := c.Get("missing"); ok {
+		t.Error("Get(missing) found a value")
+	}
+
+	if err := c.Prune(); err != nil {
+		t.Fatal(err)
+	}
+	var keys int
+	c.db.View(func(tx *bolt.Tx) error {
+		keys = tx.Bucket(bucket).Stats().KeyN
+		return nil
+	})
+	if keys != 2 {
+		t.Errorf("got %d keys after Prune, want 2", keys)
+	}
+
+	c.Delete("kept")
+	if _, ok := c.Get("kept"); ok {
+		t.Error("Get(kept) found the deleted value")
+	}
+}
+
+func TestCacheSurvivesRestart(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Write([]byte(`{"id":"5b11f4ce-a62d-471e-81fc-a69a8278c7da","name":"Nirvana"}`))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cache.db")
+	for i := 0; i < 2; i++ {
+		cache, err := Open(path, time.Second)
+		if err != nil {
+			t.Fatal(err)
+		}
+		client, err := musicbrainz.NewClient(musicbrainz.WithBaseURL(server.URL), musicbrainz.WithoutRateLimit(), musicbrainz.WithCache(cache, time.Hour))
+		if err != nil {
+			t.Fatal(err)
+		}
+		artist, err := client.GetArtistByID(context.Background(), "5b11f4ce-a62d-471e-81fc-a69a8278c7da")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if artist.Name != "Nirvana" {
+			t.Errorf("got artist %+v", artist)
+		}
+		if err := cache.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if requests := atomic.LoadInt32(&requests); requests != 1 {
+		t.Errorf("got %d requests, want 1", requests)
+	}
+}
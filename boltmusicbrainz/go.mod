@@ -0,0 +1,12 @@
+module github.com/gcottom/musicbrainz/boltmusicbrainz
+
+go 1.21
+
+replace github.com/gcottom/musicbrainz => ../
+
+require (
+	github.com/gcottom/musicbrainz v0.0.0
+	go.etcd.io/bbolt v1.3.10
+)
+
+require golang.org/x/sys v0.4.0 // indirect
@@ -0,0 +1,116 @@
+package musicbrainz
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// WithCircuitBreaker makes the client stop sending requests to the
+// MusicBrainz API once threshold calls in a row failed with a 5xx status or
+// without a response, such as timeouts, after their retries. Requests then
+// fail fast with an error wrapping ErrCircuitOpen. Once cooldown has
+// elapsed, a single request is let through as a probe: its success closes
+// the circuit, its failure keeps it open for another cooldown. This keeps
+// batch jobs from hammering MusicBrainz during maintenance windows. Cover
+// Art Archive and Wikidata requests are not affected.
+func WithCircuitBreaker(threshold int, cooldown time.Duration) Option {
+	return func(c *Client) error {
+		if threshold < 1 {
+			return errors.New("musicbrainz: circuit breaker threshold must be at least 1")
+		}
+		if cooldown <= 0 {
+			return errors.New("musicbrainz: circuit breaker cooldown must be positive")
+		}
+		c.breaker = &circuitBreaker{threshold: threshold, cooldown: cooldown}
+		return nil
+	}
+}
+
+// WithStaleOnOutage makes requests answer with their cached response due for
+// revalidation, rather than fail, while the circuit breaker is open. It
+// requires WithCircuitBreaker and WithCacheRevalidation.
+func WithStaleOnOutage() Option {
+	return func(c *Client) error {
+		c.staleOnOutage = true
+		return nil
+	}
+}
+
+// circuitBreaker counts the consecutive failed calls to the API. The circuit
+// is open while the count is at least the threshold.
+type circuitBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	failures  int
+	opened    time.Time
+	probing   bool
+}
+
+// callOutcome is how the result of a call affects a circuitBreaker
+type callOutcome int
+
+const (
+	callNeutral callOutcome = iota
+	callSucceeded
+	callFailed
+)
+
+// allow reports whether a call may be made, and whether it is the probe of
+// an open circuit
+func (b *circuitBreaker) allow() (ok, probe bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failures < b.threshold {
+		return true, false
+	}
+	if b.probing || time.Since(b.opened) < b.cooldown {
+		return false, false
+	}
+	b.probing = true
+	return true, true
+}
+
+// record updates the breaker with the outcome of a call
+func (b *circuitBreaker) record(probe bool, outcome callOutcome) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if probe {
+		b.probing = false
+	}
+	switch outcome {
+	case callSucceeded:
+		b.failures = 0
+	case callFailed:
+		b.failures++
+		if b.failures >= b.threshold {
+			b.opened = time.Now()
+		}
+	}
+}
+
+// outcome classifies the result of a call for the circuit breaker. Calls
+// canceled by the caller and rate limited calls tell nothing about an
+// outage.
+func outcome(ctx context.Context, response *http.Response, err error) callOutcome {
+	switch {
+	case err != nil && errors.Is(ctx.Err(), context.Canceled):
+		return callNeutral
+	case err != nil:
+		return callFailed
+	case response.StatusCode == http.StatusTooManyRequests:
+		return callNeutral
+	case response.StatusCode >= http.StatusInternalServerError:
+		return callFailed
+	}
+	return callSucceeded
+}
+
+// guarded reports whether request goes through the circuit breaker
+func (c *Client) guarded(request *http.Request) bool {
+	return c.breaker != nil && strings.HasPrefix(request.URL.String(), c.baseURL)
+}
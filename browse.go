@@ -0,0 +1,390 @@
+package musicbrainz
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ReleaseBrowseResult is a page of releases returned by a browse request
+type ReleaseBrowseResult struct {
+	Count    int       `json:"release-count"`
+	Offset   int       `json:"release-offset"`
+	Releases []Release `json:"releases"`
+}
+
+// ReleaseGroupBrowseResult is a page of release groups returned by a browse
+// request
+type ReleaseGroupBrowseResult struct {
+	Count         int            `json:"release-group-count"`
+	Offset        int            `json:"release-group-offset"`
+	ReleaseGroups []ReleaseGroup `json:"release-groups"`
+}
+
+// RecordingBrowseResult is a page of recordings returned by a browse request
+type RecordingBrowseResult struct {
+	Count      int         `json:"recording-count"`
+	Offset     int         `json:"recording-offset"`
+	Recordings []Recording `json:"recordings"`
+}
+
+// ArtistBrowseResult is a page of artists returned by a browse request
+type ArtistBrowseResult struct {
+	Count   int      `json:"artist-count"`
+	Offset  int      `json:"artist-offset"`
+	Artists []Artist `json:"artists"`
+}
+
+// WorkBrowseResult is a page of works returned by a browse request
+type WorkBrowseResult struct {
+	Count  int    `json:"work-count"`
+	Offset int    `json:"work-offset"`
+	Works  []Work `json:"works"`
+}
+
+// EventBrowseResult is a page of events returned by a browse request
+type EventBrowseResult struct {
+	Count  int     `json:"event-count"`
+	Offset int     `json:"event-offset"`
+	Events []Event `json:"events"`
+}
+
+// LabelBrowseResult is a page of labels returned by a browse request
+type LabelBrowseResult struct {
+	Count  int     `json:"label-count"`
+	Offset int     `json:"label-offset"`
+	Labels []Label `json:"labels"`
+}
+
+// browse retrieves the entities of the given type linked to the linkedEntity
+// with the given ID into v. Browsing, unlike searching, enumerates every
+// linked entity.
+func (c *Client) browse(ctx context.Context, entity, linkedEntity string, id MBID, opts []RequestOption, v any) error {
+	if err := id.validate(); err != nil {
+		return err
+	}
+	o := collectOptions(opts)
+	if o.requiresAuthentication() && !c.authenticated() {
+		return ErrUnauthenticated
+	}
+	params := url.Values{}
+	params.Set(linkedEntity, string(id))
+	if err := o.setIncludes(params, entity); err != nil {
+		return err
+	}
+	if o.limit > 0 {
+		params.Set("limit", strconv.Itoa(o.limit))
+	}
+	if o.offset > 0 {
+		params.Set("offset", strconv.Itoa(o.offset))
+	}
+	if len(o.statuses) > 0 {
+		params.Set("status", strings.ToLower(strings.Join(o.statuses, "|")))
+	}
+	if len(o.types) > 0 {
+		params.Set("type", strings.ToLower(strings.Join(o.types, "|")))
+	}
+
+	return c.get(ctx, entity, params, o.target(v))
+}
+
+// BrowseReleasesByArtist retrieves a page of the releases of an artist. Use
+// WithLimit and WithOffset to page through them, WithStatus and WithType to
+// filter them and WithIncludes to request subqueries.
+func (c *Client) BrowseReleasesByArtist(ctx context.Context, artistID MBID, opts ...RequestOption) (*ReleaseBrowseResult, error) {
+	var result ReleaseBrowseResult
+	if err := c.browse(ctx, "release", "artist", artistID, opts, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// BrowseReleasesByLabel retrieves a page of the releases of a label. Use
+// WithLimit and WithOffset to page through them, WithStatus and WithType to
+// filter them and WithIncludes to request subqueries.
+func (c *Client) BrowseReleasesByLabel(ctx context.Context, labelID MBID, opts ...RequestOption) (*ReleaseBrowseResult, error) {
+	var result ReleaseBrowseResult
+	if err := c.browse(ctx, "release", "label", labelID, opts, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// BrowseReleasesByReleaseGroup retrieves a page of the releases in a release
+// group, that is the editions of an album. Use WithLimit and WithOffset to
+// page through them and WithIncludes to request subqueries.
+func (c *Client) BrowseReleasesByReleaseGroup(ctx context.Context, releaseGroupID MBID, opts ...RequestOption) (*ReleaseBrowseResult, error) {
+	var result ReleaseBrowseResult
+	if err := c.browse(ctx, "release", "release-group", releaseGroupID, opts, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// BrowseReleaseGroupsByArtist retrieves a page of the release groups of an
+// artist. Use WithType to restrict them to albums, singles or EPs, and
+// WithLimit and WithOffset to page through them.
+func (c *Client) BrowseReleaseGroupsByArtist(ctx context.Context, artistID MBID, opts ...RequestOption) (*ReleaseGroupBrowseResult, error) {
+	var result ReleaseGroupBrowseResult
+	if err := c.browse(ctx, "release-group", "artist", artistID, opts, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// BrowseRecordingsByArtist retrieves a page of the recordings of an artist.
+// Use WithLimit and WithOffset to page through them and WithIncludes to
+// request subqueries.
+func (c *Client) BrowseRecordingsByArtist(ctx context.Context, artistID MBID, opts ...RequestOption) (*RecordingBrowseResult, error) {
+	var result RecordingBrowseResult
+	if err := c.browse(ctx, "recording", "artist", artistID, opts, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// BrowseRecordingsByRelease retrieves a page of the recordings on a release.
+// Use WithLimit and WithOffset to page through them and WithIncludes to
+// request subqueries.
+func (c *Client) BrowseRecordingsByRelease(ctx context.Context, releaseID MBID, opts ...RequestOption) (*RecordingBrowseResult, error) {
+	var result RecordingBrowseResult
+	if err := c.browse(ctx, "recording", "release", releaseID, opts, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// BrowseArtistsByArea retrieves a page of the artists from an area, such as
+// a country or a city. Use WithLimit and WithOffset to page through them and
+// WithIncludes to request subqueries.
+func (c *Client) BrowseArtistsByArea(ctx context.Context, areaID MBID, opts ...RequestOption) (*ArtistBrowseResult, error) {
+	var result ArtistBrowseResult
+	if err := c.browse(ctx, "artist", "area", areaID, opts, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// BrowseArtistsByRecording retrieves a page of the artists credited on a
+// recording. Use WithLimit and WithOffset to page through them and
+// WithIncludes to request subqueries.
+func (c *Client) BrowseArtistsByRecording(ctx context.Context, recordingID MBID, opts ...RequestOption) (*ArtistBrowseResult, error) {
+	var result ArtistBrowseResult
+	if err := c.browse(ctx, "artist", "recording", recordingID, opts, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// BrowseArtistsByRelease retrieves a page of the artists credited on a
+// release or on any of its tracks, answering who performed on it. Use
+// WithLimit and WithOffset to page through them and WithIncludes to request
+// subqueries.
+func (c *Client) BrowseArtistsByRelease(ctx context.Context, releaseID MBID, opts ...RequestOption) (*ArtistBrowseResult, error) {
+	var result ArtistBrowseResult
+	if err := c.browse(ctx, "artist", "release", releaseID, opts, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// BrowseArtistsByWork retrieves a page of the artists linked to a work, such
+// as its composers and lyricists. Use WithLimit and WithOffset to page
+// through them and WithIncludes to request subqueries.
+func (c *Client) BrowseArtistsByWork(ctx context.Context, workID MBID, opts ...RequestOption) (*ArtistBrowseResult, error) {
+	var result ArtistBrowseResult
+	if err := c.browse(ctx, "artist", "work", workID, opts, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// BrowseWorksByArtist retrieves a page of the works an artist is linked to,
+// such as the songs they wrote. Use WithLimit and WithOffset to page through
+// them and WithIncludes to request subqueries.
+func (c *Client) BrowseWorksByArtist(ctx context.Context, artistID MBID, opts ...RequestOption) (*WorkBrowseResult, error) {
+	var result WorkBrowseResult
+	if err := c.browse(ctx, "work", "artist", artistID, opts, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// BrowseEventsByArtist retrieves a page of the events an artist took part
+// in, such as their concerts and festivals. Use WithLimit and WithOffset to
+// page through them and WithIncludes to request subqueries.
+func (c *Client) BrowseEventsByArtist(ctx context.Context, artistID MBID, opts ...RequestOption) (*EventBrowseResult, error) {
+	var result EventBrowseResult
+	if err := c.browse(ctx, "event", "artist", artistID, opts, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// BrowseLabelsByRelease retrieves a page of the labels a release was issued
+// on. Use WithLimit and WithOffset to page through them and WithIncludes to
+// request subqueries.
+func (c *Client) BrowseLabelsByRelease(ctx context.Context, releaseID MBID, opts ...RequestOption) (*LabelBrowseResult, error) {
+	var result LabelBrowseResult
+	if err := c.browse(ctx, "label", "release", releaseID, opts, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// BrowseAllReleasesByArtist returns a Pager over all the releases of an artist
+func (c *Client) BrowseAllReleasesByArtist(ctx context.Context, artistID MBID, opts ...RequestOption) *Pager[Release] {
+	return newPager(ctx, func(ctx context.Context, offset int) ([]Release, int, error) {
+		result, err := c.BrowseReleasesByArtist(ctx, artistID, pageOptions(opts, offset)...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.Releases, result.Count, nil
+	})
+}
+
+// BrowseAllReleasesByLabel returns a Pager over all the releases of a label
+func (c *Client) BrowseAllReleasesByLabel(ctx context.Context, labelID MBID, opts ...RequestOption) *Pager[Release] {
+	return newPager(ctx, func(ctx context.Context, offset int) ([]Release, int, error) {
+		result, err := c.BrowseReleasesByLabel(ctx, labelID, pageOptions(opts, offset)...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.Releases, result.Count, nil
+	})
+}
+
+// BrowseAllReleasesByReleaseGroup returns a Pager over all the releases in a release group
+func (c *Client) BrowseAllReleasesByReleaseGroup(ctx context.Context, releaseGroupID MBID, opts ...RequestOption) *Pager[Release] {
+	return newPager(ctx, func(ctx context.Context, offset int) ([]Release, int, error) {
+		result, err := c.BrowseReleasesByReleaseGroup(ctx, releaseGroupID, pageOptions(opts, offset)...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.Releases, result.Count, nil
+	})
+}
+
+// BrowseAllReleaseGroupsByArtist returns a Pager over all the release groups of an artist
+func (c *Client) BrowseAllReleaseGroupsByArtist(ctx context.Context, artistID MBID, opts ...RequestOption) *Pager[ReleaseGroup] {
+	return newPager(ctx, func(ctx context.Context, offset int) ([]ReleaseGroup, int, error) {
+		result, err := c.BrowseReleaseGroupsByArtist(ctx, artistID, pageOptions(opts, offset)...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.ReleaseGroups, result.Count, nil
+	})
+}
+
+// BrowseAllRecordingsByArtist returns a Pager over all the recordings of an artist
+func (c *Client) BrowseAllRecordingsByArtist(ctx context.Context, artistID MBID, opts ...RequestOption) *Pager[Recording] {
+	return newPager(ctx, func(ctx context.Context, offset int) ([]Recording, int, error) {
+		result, err := c.BrowseRecordingsByArtist(ctx, artistID, pageOptions(opts, offset)...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.Recordings, result.Count, nil
+	})
+}
+
+// BrowseAllRecordingsByRelease returns a Pager over all the recordings on a release
+func (c *Client) BrowseAllRecordingsByRelease(ctx context.Context, releaseID MBID, opts ...RequestOption) *Pager[Recording] {
+	return newPager(ctx, func(ctx context.Context, offset int) ([]Recording, int, error) {
+		result, err := c.BrowseRecordingsByRelease(ctx, releaseID, pageOptions(opts, offset)...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.Recordings, result.Count, nil
+	})
+}
+
+// BrowseAllArtistsByArea returns a Pager over all the artists from an area
+func (c *Client) BrowseAllArtistsByArea(ctx context.Context, areaID MBID, opts ...RequestOption) *Pager[Artist] {
+	return newPager(ctx, func(ctx context.Context, offset int) ([]Artist, int, error) {
+		result, err := c.BrowseArtistsByArea(ctx, areaID, pageOptions(opts, offset)...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.Artists, result.Count, nil
+	})
+}
+
+// BrowseAllArtistsByRecording returns a Pager over all the artists credited on a recording
+func (c *Client) BrowseAllArtistsByRecording(ctx context.Context, recordingID MBID, opts ...RequestOption) *Pager[Artist] {
+	return newPager(ctx, func(ctx context.Context, offset int) ([]Artist, int, error) {
+		result, err := c.BrowseArtistsByRecording(ctx, recordingID, pageOptions(opts, offset)...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.Artists, result.Count, nil
+	})
+}
+
+// BrowseAllArtistsByRelease returns a Pager over all the artists credited on a release
+func (c *Client) BrowseAllArtistsByRelease(ctx context.Context, releaseID MBID, opts ...RequestOption) *Pager[Artist] {
+	return newPager(ctx, func(ctx context.Context, offset int) ([]Artist, int, error) {
+		result, err := c.BrowseArtistsByRelease(ctx, releaseID, pageOptions(opts, offset)...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.Artists, result.Count, nil
+	})
+}
+
+// BrowseAllArtistsByWork returns a Pager over all the artists linked to a work
+func (c *Client) BrowseAllArtistsByWork(ctx context.Context, workID MBID, opts ...RequestOption) *Pager[Artist] {
+	return newPager(ctx, func(ctx context.Context, offset int) ([]Artist, int, error) {
+		result, err := c.BrowseArtistsByWork(ctx, workID, pageOptions(opts, offset)...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.Artists, result.Count, nil
+	})
+}
+
+// BrowseAllWorksByArtist returns a Pager over all the works of an artist
+func (c *Client) BrowseAllWorksByArtist(ctx context.Context, artistID MBID, opts ...RequestOption) *Pager[Work] {
+	return newPager(ctx, func(ctx context.Context, offset int) ([]Work, int, error) {
+		result, err := c.BrowseWorksByArtist(ctx, artistID, pageOptions(opts, offset)...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.Works, result.Count, nil
+	})
+}
+
+// BrowseAllEventsByArtist returns a Pager over all the events of an artist
+func (c *Client) BrowseAllEventsByArtist(ctx context.Context, artistID MBID, opts ...RequestOption) *Pager[Event] {
+	return newPager(ctx, func(ctx context.Context, offset int) ([]Event, int, error) {
+		result, err := c.BrowseEventsByArtist(ctx, artistID, pageOptions(opts, offset)...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.Events, result.Count, nil
+	})
+}
+
+// BrowseAllLabelsByRelease returns a Pager over all the labels of a release
+func (c *Client) BrowseAllLabelsByRelease(ctx context.Context, releaseID MBID, opts ...RequestOption) *Pager[Label] {
+	return newPager(ctx, func(ctx context.Context, offset int) ([]Label, int, error) {
+		result, err := c.BrowseLabelsByRelease(ctx, releaseID, pageOptions(opts, offset)...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.Labels, result.Count, nil
+	})
+}
@@ -0,0 +1,266 @@
+package musicbrainz
+
+import (
+	"container/list"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Cache stores API responses so that repeated requests are answered without
+// reaching the network or waiting for the rate limiter. Keys are request
+// URLs, including the requested includes, and values are opaque to the
+// cache. Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the value stored for key, if any and not expired
+	Get(key string) ([]byte, bool)
+	// Set stores value for key. A positive ttl is the duration after which
+	// the value expires.
+	Set(key string, value []byte, ttl time.Duration)
+	// Delete removes the value stored for key, if any
+	Delete(key string)
+}
+
+// WithCache caches successful responses in cache for ttl, or until evicted
+// by the cache if ttl is zero. Use NewMemoryCache for an in-memory cache.
+func WithCache(cache Cache, ttl time.Duration) Option {
+	return func(c *Client) error {
+		if cache == nil {
+			return errors.New("musicbrainz: nil cache")
+		}
+		if ttl < 0 {
+			return errors.New("musicbrainz: cache TTL must not be negative")
+		}
+		c.cache = cache
+		c.cacheTTL = ttl
+		return nil
+	}
+}
+
+// WithNegativeCache remembers for ttl that requested entities don't exist,
+// answering further requests for them with ErrNotFound without reaching the
+// network. It requires WithCache.
+func WithNegativeCache(ttl time.Duration) Option {
+	return func(c *Client) error {
+		if ttl <= 0 {
+			return errors.New("musicbrainz: negative cache TTL must be positive")
+		}
+		c.negativeTTL = ttl
+		return nil
+	}
+}
+
+// WithCacheRevalidation makes cached responses older than maxAge be
+// revalidated with a conditional request, sending the ETag and Last-Modified
+// validators of the cached response. A 304 Not Modified answer refreshes the
+// cached response without transferring it again. The TTL given to WithCache
+// should be longer than maxAge for responses to be kept for revalidation.
+func WithCacheRevalidation(maxAge time.Duration) Option {
+	return func(c *Client) error {
+		if maxAge <= 0 {
+			return errors.New("musicbrainz: cache revalidation age must be positive")
+		}
+		c.revalidateAfter = maxAge
+		return nil
+	}
+}
+
+// WithOfflineMode answers every request from the cache, without ever
+// reaching the network. Requests whose response isn't cached fail with an
+// error wrapping ErrNotCached, and stale responses are used without
+// revalidation. It requires WithCache, for processing previously crawled
+// data without network access.
+func WithOfflineMode() Option {
+	return func(c *Client) error {
+		c.offline = true
+		return nil
+	}
+}
+
+// cacheEntry is a response as stored in a Cache. Successful responses hold
+// their body; not found responses, when negative caching is enabled, hold
+// their status code and error message.
+type cacheEntry struct {
+	Body         json.RawMessage `json:"body,omitempty"`
+	StatusCode   int             `json:"status,omitempty"`
+	Message      string          `json:"message,omitempty"`
+	ETag         string          `json:"etag,omitempty"`
+	LastModified string          `json:"last-modified,omitempty"`
+	Stored       time.Time       `json:"stored"`
+}
+
+// newCacheEntry returns the entry caching a successful response with body
+func newCacheEntry(response *http.Response, body []byte) cacheEntry {
+	return cacheEntry{
+		Body:         body,
+		ETag:         response.Header.Get("ETag"),
+		LastModified: response.Header.Get("Last-Modified"),
+		Stored:       time.Now(),
+	}
+}
+
+// setValidators adds the conditional headers revalidating the entry to
+// request
+func (e cacheEntry) setValidators(request *http.Request) {
+	if e.ETag != "" {
+		request.Header.Set("If-None-Match", e.ETag)
+	}
+	if e.LastModified != "" {
+		request.Header.Set("If-Modified-Since", e.LastModified)
+	}
+}
+
+// err returns the error of a cached error response requested from rawURL,
+// or nil if the entry is a successful response
+func (e cacheEntry) err(rawURL string) error {
+	if e.StatusCode == 0 {
+		return nil
+	}
+	return &APIError{StatusCode: e.StatusCode, Message: e.Message, URL: rawURL}
+}
+
+// cached returns the entry stored in the cache for key
+func (c *Client) cached(key string) (cacheEntry, bool) {
+	var entry cacheEntry
+	if c.cache == nil {
+		return entry, false
+	}
+	value, ok := c.cache.Get(key)
+	if !ok {
+		return entry, false
+	}
+	if err := json.Unmarshal(value, &entry); err != nil {
+		// Entries written by an incompatible version are dropped
+		c.cache.Delete(key)
+		return entry, false
+	}
+	return entry, true
+}
+
+// stale reports whether a cached successful response must be revalidated
+// before use
+func (c *Client) stale(entry cacheEntry) bool {
+	return c.revalidateAfter > 0 && !c.offline && entry.StatusCode == 0 && time.Since(entry.Stored) > c.revalidateAfter
+}
+
+// store saves entry in the cache under key for ttl
+func (c *Client) store(key string, entry cacheEntry, ttl time.Duration) {
+	if c.cache == nil {
+		return
+	}
+	value, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	c.cache.Set(key, value, ttl)
+}
+
+// Preload stores body in the cache as the response to the lookup of the
+// entity of the given type and ID with opts, such as a document from a
+// MusicBrainz data dump. Lookups with the same options are then answered
+// from the cache, in offline mode too, until the cache TTL expires. body is a
+// JSON document, so clients requesting XML can't be preloaded.
+func (c *Client) Preload(entity string, id MBID, body []byte, opts ...RequestOption) error {
+	if c.cache == nil {
+		return errors.New("musicbrainz: preloading requires a cache")
+	}
+	if c.format != FormatJSON {
+		return errors.New("musicbrainz: preloading requires the JSON format")
+	}
+	rawURL, err := c.lookupURL(entity, id, collectOptions(opts))
+	if err != nil {
+		return err
+	}
+	c.store(rawURL, cacheEntry{Body: body, Stored: time.Now()}, c.cacheTTL)
+	return nil
+}
+
+// MemoryCache is an in-memory Cache that evicts the least recently used
+// values once it holds its maximum number of values. It is safe for
+// concurrent use.
+type MemoryCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	entries    map[string]*list.Element
+	order      *list.List
+}
+
+// memoryEntry is a value stored in a MemoryCache
+type memoryEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// NewMemoryCache returns a MemoryCache holding up to maxEntries values, or
+// an unbounded number of values if maxEntries is zero
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	return &MemoryCache{
+		maxEntries: maxEntries,
+		entries:    make(map[string]*list.Element),
+		order:      list.New(),
+	}
+}
+
+// Get returns the value stored for key, if any and not expired
+func (m *MemoryCache) Get(key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	element, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := element.Value.(*memoryEntry)
+	if !entry.expires.IsZero() && time.Now().After(entry.expires) {
+		m.remove(element)
+		return nil, false
+	}
+	m.order.MoveToFront(element)
+	return entry.value, true
+}
+
+// Set stores value for key, evicting the least recently used value if the
+// cache is full
+func (m *MemoryCache) Set(key string, value []byte, ttl time.Duration) {
+	var expires time.Time
+	if ttl > 0 {
+		expires = time.Now().Add(ttl)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if element, ok := m.entries[key]; ok {
+		entry := element.Value.(*memoryEntry)
+		entry.value, entry.expires = value, expires
+		m.order.MoveToFront(element)
+		return
+	}
+	m.entries[key] = m.order.PushFront(&memoryEntry{key: key, value: value, expires: expires})
+	if m.maxEntries > 0 && m.order.Len() > m.maxEntries {
+		m.remove(m.order.Back())
+	}
+}
+
+// Delete removes the value stored for key, if any
+func (m *MemoryCache) Delete(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if element, ok := m.entries[key]; ok {
+		m.remove(element)
+	}
+}
+
+// Len returns the number of values in the cache, including expired values
+// not yet evicted
+func (m *MemoryCache) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.order.Len()
+}
+
+func (m *MemoryCache) remove(element *list.Element) {
+	m.order.Remove(element)
+	delete(m.entries, element.Value.(*memoryEntry).key)
+}
@@ -0,0 +1,36 @@
+package musicbrainz
+
+import "context"
+
+// ReleasePreferences orders the releases considered by GetCanonicalRelease
+// and ReleasePicker. Each list is in order of preference, and releases matching none of its
+// entries come after those matching one. Matching is case-insensitive.
+type ReleasePreferences struct {
+	// Statuses defaults to ReleaseStatusOfficial alone when empty
+	Statuses []ReleaseStatus
+	// Countries holds ISO 3166-1 codes, or "XW" for worldwide releases
+	Countries []string
+	// Formats holds medium formats, such as "CD" or "Digital Media"
+	Formats []string
+}
+
+// GetCanonicalRelease returns the release a recording is best known from,
+// for filling the album of a tagged file. Releases are ranked by their
+// status, then by the kind of their release group, studio albums first and
+// compilations last, then by country and format as set with
+// WithReleasePreferences, and finally by date, earliest first. By default
+// this picks the earliest official non-compilation album. It returns
+// ErrNotFound if the recording appears on no release.
+func (c *Client) GetCanonicalRelease(ctx context.Context, recordingID MBID, opts ...RequestOption) (*Release, error) {
+	o := collectOptions(opts)
+	recording, err := c.GetRecordingByID(ctx, recordingID, WithIncludes("releases", "release-groups", "media"))
+	if err != nil {
+		return nil, err
+	}
+	if len(recording.Releases) == 0 {
+		return nil, ErrNotFound
+	}
+
+	picker := ReleasePicker{ReleasePreferences: o.releasePreferences}
+	return picker.Pick(recording.Releases), nil
+}
@@ -0,0 +1,56 @@
+package musicbrainz
+
+// VariousArtistsID is the MBID of the special "Various Artists" artist,
+// credited on compilations of tracks by different artists
+const VariousArtistsID MBID = "89ad4ac3-39f7-470e-963a-56509c546377"
+
+// IsVariousArtists reports whether an artist credit is, or includes, the
+// special "Various Artists" artist
+func IsVariousArtists(credits ArtistCredits) bool {
+	for _, credit := range credits {
+		if credit.Artist.ID == VariousArtistsID {
+			return true
+		}
+	}
+	return false
+}
+
+// IsCompilation reports whether a release is a compilation, either because
+// its release group has the Compilation secondary type or because it is
+// credited to Various Artists. This is the value of the COMPILATION tag set
+// by players and taggers.
+func IsCompilation(release *Release) bool {
+	return release.ReleaseGroup.HasSecondaryType(ReleaseGroupSecondaryTypeCompilation) ||
+		IsVariousArtists(release.ArtistCredit) ||
+		IsVariousArtists(release.ReleaseGroup.ArtistCredit)
+}
+
+// IsSoundtrack reports whether a release is the soundtrack of a film, show
+// or game
+func IsSoundtrack(release *Release) bool {
+	return release.ReleaseGroup.HasSecondaryType(ReleaseGroupSecondaryTypeSoundtrack)
+}
+
+// IsLive reports whether a release is a live recording
+func IsLive(release *Release) bool {
+	return release.ReleaseGroup.HasSecondaryType(ReleaseGroupSecondaryTypeLive)
+}
+
+// HasMultipleArtists reports whether the tracks of a release are credited to
+// different artists, as on compilations not yet typed as such. It needs the
+// release to have been retrieved with the "recordings" and "artist-credits"
+// includes.
+func HasMultipleArtists(release *Release) bool {
+	var first string
+	for _, medium := range release.Media {
+		for _, track := range medium.Tracks {
+			credit := track.ArtistCredit.String()
+			if first == "" {
+				first = credit
+			} else if credit != first {
+				return true
+			}
+		}
+	}
+	return false
+}
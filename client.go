@@ -0,0 +1,657 @@
+package musicbrainz
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Client is a MusicBrainz API client. A Client is safe for concurrent use
+// by multiple goroutines.
+type Client struct {
+	httpClient          *http.Client
+	transport           *http.Transport
+	transportConfigured bool
+	baseURL             string
+	coverArtURL         string
+	wikidataURL         string
+	userAgent           string
+	limiter             *rateLimiter
+	retry               retryPolicy
+	strict              bool
+	cache               Cache
+	cacheTTL            time.Duration
+	negativeTTL         time.Duration
+	revalidateAfter     time.Duration
+	offline             bool
+	flights             flightGroup
+	maxResponseSize     int64
+	username            string
+	password            string
+	tokenSource         TokenSource
+	clientID            string
+	hooks               []Hooks
+	logger              *slog.Logger
+	observers           []CallObserver
+	metrics             []Metrics
+	breaker             *circuitBreaker
+	staleOnOutage       bool
+	format              Format
+}
+
+// defaultMaxResponseSize is the largest API response a Client reads unless
+// set with WithMaxResponseSize. It is far above the size of the largest
+// releases with all their recordings.
+const defaultMaxResponseSize = 32 << 20
+
+// defaultUserAgent identifies requests from clients that did not configure
+// their own User-Agent
+const defaultUserAgent = "gcottom-musicbrainz ( https://github.com/gcottom/musicbrainz )"
+
+// defaultClientID identifies submissions from clients that did not configure
+// their User-Agent
+const defaultClientID = "gcottom-musicbrainz"
+
+// Option configures a Client
+type Option func(*Client) error
+
+// DefaultClient is the Client used by the package-level functions
+var DefaultClient = newClient()
+
+// NewClient returns a new Client configured with the given options
+func NewClient(opts ...Option) (*Client, error) {
+	c := newClient()
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
+	if c.limiter.interval == 0 && isOfficialServer(c.baseURL) {
+		return nil, errors.New("musicbrainz: rate limiting can only be disabled for private mirrors")
+	}
+	if c.transportConfigured && c.httpClient.Transport != c.transport {
+		return nil, errors.New("musicbrainz: transport options can't be combined with WithHTTPClient")
+	}
+	if c.username != "" && c.tokenSource != nil {
+		return nil, errors.New("musicbrainz: credentials can't be combined with OAuth")
+	}
+	if c.username != "" || c.tokenSource != nil {
+		if err := c.authenticate(); err != nil {
+			return nil, err
+		}
+	}
+	if c.negativeTTL > 0 && c.cache == nil {
+		return nil, errors.New("musicbrainz: negative caching requires a cache")
+	}
+	if c.revalidateAfter > 0 && c.cache == nil {
+		return nil, errors.New("musicbrainz: cache revalidation requires a cache")
+	}
+	if c.offline && c.cache == nil {
+		return nil, errors.New("musicbrainz: offline mode requires a cache")
+	}
+	if c.strict && c.format == FormatXML {
+		return nil, errors.New("musicbrainz: strict decoding requires the JSON format")
+	}
+	if c.staleOnOutage && (c.breaker == nil || c.revalidateAfter == 0) {
+		return nil, errors.New("musicbrainz: serving stale responses requires a circuit breaker and cache revalidation")
+	}
+	return c, nil
+}
+
+// isOfficialServer reports whether baseURL points at a server run by
+// MetaBrainz, which enforces rate limiting
+func isOfficialServer(baseURL string) bool {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return false
+	}
+	host := u.Hostname()
+	return host == "musicbrainz.org" || strings.HasSuffix(host, ".musicbrainz.org")
+}
+
+func newClient() *Client {
+	transport := newTransport()
+	return &Client{
+		httpClient:      &http.Client{Transport: transport},
+		transport:       transport,
+		baseURL:         MusicBrainzAPIEndpoint,
+		coverArtURL:     CoverArtArchiveEndpoint,
+		wikidataURL:     WikidataEndpoint,
+		userAgent:       defaultUserAgent,
+		clientID:        defaultClientID,
+		limiter:         newRateLimiter(time.Second, 1),
+		retry:           defaultRetryPolicy,
+		format:          FormatJSON,
+		maxResponseSize: defaultMaxResponseSize,
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to make requests. By default, a
+// Client uses an HTTP client of its own, which WithMaxIdleConnsPerHost,
+// WithTLSConfig and WithProxy configure.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) error {
+		if httpClient == nil {
+			return errors.New("musicbrainz: nil HTTP client")
+		}
+		c.httpClient = httpClient
+		return nil
+	}
+}
+
+// WithBaseURL sets the base URL of the MusicBrainz API, for example
+// TestMusicBrainzAPIEndpoint or a local musicbrainz-docker mirror. A URL
+// without a path is assumed to serve the API under /ws/2/.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) error {
+		u, err := parseBaseURL(baseURL)
+		if err != nil {
+			return err
+		}
+		if u.Path == "/" {
+			u.Path = "/ws/2/"
+		}
+		c.baseURL = u.String()
+		return nil
+	}
+}
+
+// WithCoverArtBaseURL sets the base URL of the Cover Art Archive API
+func WithCoverArtBaseURL(baseURL string) Option {
+	return func(c *Client) error {
+		u, err := parseBaseURL(baseURL)
+		if err != nil {
+			return err
+		}
+		c.coverArtURL = u.String()
+		return nil
+	}
+}
+
+// WithWikidataBaseURL sets the base URL of the Wikidata entity data
+// endpoint used by EnrichArtistFromWikidata
+func WithWikidataBaseURL(baseURL string) Option {
+	return func(c *Client) error {
+		u, err := parseBaseURL(baseURL)
+		if err != nil {
+			return err
+		}
+		c.wikidataURL = u.String()
+		return nil
+	}
+}
+
+// parseBaseURL validates an API base URL and ensures its path ends with a
+// slash
+func parseBaseURL(baseURL string) (*url.URL, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("musicbrainz: invalid base URL: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("musicbrainz: invalid base URL %q: scheme must be http or https", baseURL)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("musicbrainz: invalid base URL %q: missing host", baseURL)
+	}
+	if u.RawQuery != "" || u.Fragment != "" {
+		return nil, fmt.Errorf("musicbrainz: invalid base URL %q: unexpected query or fragment", baseURL)
+	}
+	if !strings.HasSuffix(u.Path, "/") {
+		u.Path += "/"
+	}
+	return u, nil
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+// MusicBrainz asks every application to identify itself with its name, its
+// version and a contact URL or email address, and throttles clients that
+// don't. The header is formatted as "app/version ( contact )". Submissions
+// are identified by "app-version".
+func WithUserAgent(app, version, contact string) Option {
+	return func(c *Client) error {
+		if app == "" {
+			return errors.New("musicbrainz: user agent requires an application name")
+		}
+		userAgent := app
+		if version != "" {
+			userAgent += "/" + version
+		}
+		if contact != "" {
+			userAgent += " ( " + contact + " )"
+		}
+		c.userAgent = userAgent
+		c.clientID = app
+		if version != "" {
+			c.clientID += "-" + version
+		}
+		return nil
+	}
+}
+
+// WithRateLimit limits the client to one request per interval. The default
+// of one request per second matches the MusicBrainz rate limiting rules.
+func WithRateLimit(interval time.Duration) Option {
+	return func(c *Client) error {
+		if interval <= 0 {
+			return errors.New("musicbrainz: rate limit interval must be positive")
+		}
+		c.limiter.interval = interval
+		return nil
+	}
+}
+
+// WithRateLimitBurst allows up to burst requests to be made back to back
+// before the rate limit applies
+func WithRateLimitBurst(burst int) Option {
+	return func(c *Client) error {
+		if burst < 1 {
+			return errors.New("musicbrainz: rate limit burst must be at least 1")
+		}
+		c.limiter.burst = burst
+		return nil
+	}
+}
+
+// WithoutRateLimit disables rate limiting. It is only allowed together with
+// WithBaseURL pointing at a private mirror, as musicbrainz.org blocks clients
+// exceeding its rate limit.
+func WithoutRateLimit() Option {
+	return func(c *Client) error {
+		c.limiter.interval = 0
+		return nil
+	}
+}
+
+// WithRetry configures how requests failing with a transient error (429,
+// 502, 503 or 504) are retried. Attempts are spaced with exponential backoff
+// starting at baseDelay, or as long as the server asks with a Retry-After
+// header, and capped at maxDelay. A maxAttempts of 1 disables retries.
+func WithRetry(maxAttempts int, baseDelay, maxDelay time.Duration) Option {
+	return func(c *Client) error {
+		if maxAttempts < 1 {
+			return errors.New("musicbrainz: retry attempts must be at least 1")
+		}
+		if baseDelay < 0 || maxDelay < baseDelay {
+			return errors.New("musicbrainz: invalid retry delays")
+		}
+		c.retry = retryPolicy{maxAttempts: maxAttempts, baseDelay: baseDelay, maxDelay: maxDelay}
+		return nil
+	}
+}
+
+// WithStrictDecoding makes requests fail with an *UnknownFieldsError when a
+// response contains fields that the package's types don't model, to notice
+// changes to the MusicBrainz API. It is meant for tests and development, as
+// the API adds fields over time.
+func WithStrictDecoding() Option {
+	return func(c *Client) error {
+		c.strict = true
+		return nil
+	}
+}
+
+// WithMaxResponseSize sets the largest API response, in bytes, the client
+// reads before failing with an error wrapping ErrResponseTooLarge. It guards
+// against runaway responses; cover images are not limited.
+func WithMaxResponseSize(size int64) Option {
+	return func(c *Client) error {
+		if size <= 0 {
+			return errors.New("musicbrainz: maximum response size must be positive")
+		}
+		c.maxResponseSize = size
+		return nil
+	}
+}
+
+// get requests path relative to the base URL and decodes the response into v
+func (c *Client) get(ctx context.Context, path string, params url.Values, v any) error {
+	if params == nil {
+		params = url.Values{}
+	}
+	return c.getJSON(ctx, c.limiter, c.apiURL(path, params), v)
+}
+
+// apiURL returns the URL of an API request in the format of the client
+func (c *Client) apiURL(path string, params url.Values) string {
+	params.Set("fmt", string(c.format))
+	return c.baseURL + path + "?" + params.Encode()
+}
+
+// getJSON requests rawURL, throttled by limiter if not nil, and decodes the
+// JSON response into v. Concurrent requests for the same URL decoding into
+// the same type are coalesced into one, whose decoded result is copied into
+// the v of every caller. The copies are shallow, so callers share the
+// slices of the result. A caller whose ctx is done stops waiting without
+// failing the others.
+func (c *Client) getJSON(ctx context.Context, limiter *rateLimiter, rawURL string, v any) error {
+	if _, ok := v.(*rawCapture); ok {
+		// Raw documents are captured by the call decoding them only
+		return c.decodeJSON(ctx, limiter, rawURL, v)
+	}
+
+	target := reflect.ValueOf(v).Elem()
+	key := rawURL + " " + target.Type().String()
+	result, err := c.flights.do(ctx, key, func(ctx context.Context) (any, error) {
+		// The result is decoded into a value of its own, which callers
+		// copy but never modify
+		result := reflect.New(target.Type())
+		return result, c.decodeJSON(ctx, limiter, rawURL, result.Interface())
+	})
+	if err != nil {
+		return err
+	}
+	target.Set(result.(reflect.Value).Elem())
+	return nil
+}
+
+// decodeJSON requests rawURL, throttled by limiter if not nil, and decodes the
+// JSON response into v. XML responses are converted to JSON first.
+func (c *Client) decodeJSON(ctx context.Context, limiter *rateLimiter, rawURL string, v any) error {
+	xmlResponse := isXML(rawURL)
+	if c.cache == nil && !c.strict && !xmlResponse {
+		return c.stream(ctx, limiter, rawURL, v)
+	}
+
+	body, err := c.fetch(ctx, limiter, rawURL)
+	if err != nil {
+		return err
+	}
+	if xmlResponse {
+		if body, err = xmlToJSON(body, v); err != nil {
+			return fmt.Errorf("musicbrainz: %s: %w", rawURL, err)
+		}
+	}
+
+	if err := json.Unmarshal(body, v); err != nil {
+		return err
+	}
+	if c.strict {
+		if fields := unknownFields(body, v); len(fields) > 0 {
+			return &UnknownFieldsError{URL: rawURL, Fields: fields}
+		}
+	}
+	return nil
+}
+
+// stream requests rawURL and decodes the JSON response into v as it is
+// received, without holding the whole body in memory
+func (c *Client) stream(ctx context.Context, limiter *rateLimiter, rawURL string, v any) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return err
+	}
+
+	response, err := c.do(ctx, limiter, request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if err := checkResponse(response); err != nil {
+		return err
+	}
+
+	return json.NewDecoder(c.limitBody(response)).Decode(v)
+}
+
+// fetch returns the body of a successful GET request to rawURL, answering
+// from the cache when possible
+func (c *Client) fetch(ctx context.Context, limiter *rateLimiter, rawURL string) ([]byte, error) {
+	entry, cached := c.cached(rawURL)
+	if cached {
+		if err := entry.err(rawURL); err != nil {
+			c.observeCache(rawURL, true)
+			return nil, err
+		}
+		if !c.stale(entry) {
+			c.observeCache(rawURL, true)
+			return entry.Body, nil
+		}
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	if cached {
+		entry.setValidators(request)
+	}
+
+	response, err := c.do(ctx, limiter, request)
+	if err != nil {
+		if cached && c.staleOnOutage && errors.Is(err, ErrCircuitOpen) {
+			c.observeCache(rawURL, true)
+			return entry.Body, nil
+		}
+		c.observeCache(rawURL, false)
+		return nil, err
+	}
+	defer response.Body.Close()
+	c.observeCache(rawURL, cached && response.StatusCode == http.StatusNotModified)
+	if cached && response.StatusCode == http.StatusNotModified {
+		entry.Stored = time.Now()
+		c.store(rawURL, entry, c.cacheTTL)
+		return entry.Body, nil
+	}
+	if err := checkResponse(response); err != nil {
+		var apiErr *APIError
+		if c.negativeTTL > 0 && errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			c.store(rawURL, cacheEntry{StatusCode: apiErr.StatusCode, Message: apiErr.Message, Stored: time.Now()}, c.negativeTTL)
+		}
+		return nil, err
+	}
+
+	body, err := io.ReadAll(c.limitBody(response))
+	if err != nil {
+		return nil, err
+	}
+	c.store(rawURL, newCacheEntry(response, body), c.cacheTTL)
+	return body, nil
+}
+
+// limitBody returns the body of response, failing with an error wrapping
+// ErrResponseTooLarge once more than the maximum response size is read
+func (c *Client) limitBody(response *http.Response) io.Reader {
+	return &limitedReader{r: response.Body, remaining: c.maxResponseSize, url: response.Request.URL.String()}
+}
+
+// limitedReader reads from r until more than remaining bytes are read
+type limitedReader struct {
+	r         io.Reader
+	remaining int64
+	url       string
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if l.remaining <= 0 {
+		// Probe for a byte past the limit, telling a body of exactly the
+		// maximum size from a larger one
+		var probe [1]byte
+		n, err := l.r.Read(probe[:])
+		if n > 0 {
+			return 0, fmt.Errorf("%w: %s", ErrResponseTooLarge, l.url)
+		}
+		return 0, err
+	}
+	if int64(len(p)) > l.remaining {
+		p = p[:l.remaining]
+	}
+	n, err := l.r.Read(p)
+	l.remaining -= int64(n)
+	return n, err
+}
+
+// lookup retrieves the entity of the given type and ID into v
+func (c *Client) lookup(ctx context.Context, entity string, id MBID, opts []RequestOption, v any) error {
+	o := collectOptions(opts)
+	if o.requiresAuthentication() && !c.authenticated() {
+		return ErrUnauthenticated
+	}
+	rawURL, err := c.lookupURL(entity, id, o)
+	if err != nil {
+		return err
+	}
+	return c.getJSON(ctx, c.limiter, rawURL, o.target(v))
+}
+
+// lookupURL returns the URL of the lookup of an entity by its ID
+func (c *Client) lookupURL(entity string, id MBID, o requestOptions) (string, error) {
+	if err := id.validate(); err != nil {
+		return "", err
+	}
+	params := url.Values{}
+	if err := o.setIncludes(params, entity); err != nil {
+		return "", err
+	}
+	return c.apiURL(entity+"/"+url.PathEscape(string(id)), params), nil
+}
+
+// search runs a search query for entities of the given type into v
+func (c *Client) search(ctx context.Context, entity, query string, limit int, opts []RequestOption, v any) error {
+	o := collectOptions(opts)
+	params := url.Values{}
+	params.Set("query", query)
+	params.Set("limit", strconv.Itoa(limit))
+	if o.offset > 0 {
+		params.Set("offset", strconv.Itoa(o.offset))
+	}
+	if o.dismax {
+		params.Set("dismax", "true")
+	}
+	return c.get(ctx, entity+"/", params, o.target(v))
+}
+
+// send makes an authenticated request modifying data, such as a submission,
+// to path relative to the base URL. The client parameter identifying the
+// application is added to params.
+func (c *Client) send(ctx context.Context, method, path string, params url.Values, body []byte) error {
+	if !c.authenticated() {
+		return ErrUnauthenticated
+	}
+	if params == nil {
+		params = url.Values{}
+	}
+	params.Set("client", c.clientID)
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	request, err := http.NewRequestWithContext(ctx, method, c.baseURL+path+"?"+params.Encode(), reader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		request.Header.Set("Content-Type", "application/xml; charset=utf-8")
+	}
+
+	response, err := c.do(ctx, c.limiter, request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	return checkResponse(response)
+}
+
+// do sends request, waiting for limiter before each attempt and retrying
+// transient failures. The response of the last attempt is returned whatever
+// its status.
+func (c *Client) do(ctx context.Context, limiter *rateLimiter, request *http.Request) (*http.Response, error) {
+	if c.offline {
+		return nil, fmt.Errorf("%w: %s", ErrNotCached, request.URL)
+	}
+	if !c.guarded(request) {
+		return c.observe(ctx, limiter, request)
+	}
+
+	ok, probe := c.breaker.allow()
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrCircuitOpen, request.URL)
+	}
+	response, err := c.observe(ctx, limiter, request)
+	c.breaker.record(probe, outcome(ctx, response, err))
+	return response, err
+}
+
+// observe sends request as do, notifying the call observers
+func (c *Client) observe(ctx context.Context, limiter *rateLimiter, request *http.Request) (*http.Response, error) {
+	request.Header.Set("User-Agent", c.userAgent)
+	endpoint := c.endpoint(request.URL)
+	if len(c.observers) == 0 {
+		response, _, err := c.attempt(ctx, limiter, request, endpoint)
+		return response, err
+	}
+
+	call := c.describeCall(request.Method, request.URL)
+	ends := make([]func(CallResult), len(c.observers))
+	for i, observer := range c.observers {
+		ctx, ends[i] = observer.StartCall(ctx, call)
+	}
+	request = request.WithContext(ctx)
+	response, attempts, err := c.attempt(ctx, limiter, request, endpoint)
+	result := CallResult{Attempts: attempts, Err: err}
+	if response != nil {
+		result.StatusCode = response.StatusCode
+	}
+	for i := len(ends) - 1; i >= 0; i-- {
+		ends[i](result)
+	}
+	return response, err
+}
+
+// attempt sends request until it succeeds or isn't worth retrying, and
+// returns the last response along with the number of attempts made.
+// endpoint names the request for metrics.
+func (c *Client) attempt(ctx context.Context, limiter *rateLimiter, request *http.Request, endpoint string) (*http.Response, int, error) {
+	for attempt := 1; ; attempt++ {
+		waitStart := time.Now()
+		if err := limiter.wait(ctx); err != nil {
+			return nil, attempt - 1, err
+		}
+		waited := time.Since(waitStart)
+		c.logWait(ctx, request, waited)
+		if limiter != nil {
+			c.observeRateLimitWait(endpoint, waited)
+		}
+		if attempt > 1 && request.GetBody != nil {
+			body, err := request.GetBody()
+			if err != nil {
+				return nil, attempt - 1, err
+			}
+			request.Body = body
+		}
+		c.onRequest(request)
+		start := time.Now()
+		response, err := c.httpClient.Do(request)
+		elapsed := time.Since(start)
+		c.onResponse(request, response, err, elapsed)
+		c.logResponse(ctx, request, response, err, attempt, elapsed)
+		if err != nil {
+			c.observeRequest(endpoint, 0, elapsed)
+			return nil, attempt, err
+		}
+		c.observeRequest(endpoint, response.StatusCode, elapsed)
+		if !retryable(response.StatusCode) || attempt >= c.retry.maxAttempts {
+			return response, attempt, nil
+		}
+		delay := c.retry.delay(attempt, response.Header)
+		c.onRetry(request, response, attempt, delay)
+		c.logRetry(ctx, request, response, attempt, delay)
+		c.observeRetry(endpoint)
+		io.Copy(io.Discard, io.LimitReader(response.Body, 64<<10))
+		response.Body.Close()
+		if err := sleepContext(ctx, delay); err != nil {
+			return nil, attempt, err
+		}
+	}
+}
@@ -0,0 +1,241 @@
+package musicbrainz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Collection represents a collection of entities created by a MusicBrainz
+// editor, such as a list of owned releases or an artist watchlist
+type Collection struct {
+	ID         MBID   `json:"id"`
+	Name       string `json:"name"`
+	Editor     string `json:"editor"`
+	Type       string `json:"type"`
+	EntityType string `json:"entity-type"`
+
+	AreaCount         int `json:"area-count"`
+	ArtistCount       int `json:"artist-count"`
+	EventCount        int `json:"event-count"`
+	InstrumentCount   int `json:"instrument-count"`
+	LabelCount        int `json:"label-count"`
+	PlaceCount        int `json:"place-count"`
+	RecordingCount    int `json:"recording-count"`
+	ReleaseCount      int `json:"release-count"`
+	ReleaseGroupCount int `json:"release-group-count"`
+	SeriesCount       int `json:"series-count"`
+	WorkCount         int `json:"work-count"`
+}
+
+// Size returns the number of entities in the collection
+func (c *Collection) Size() int {
+	return c.AreaCount + c.ArtistCount + c.EventCount + c.InstrumentCount + c.LabelCount + c.PlaceCount +
+		c.RecordingCount + c.ReleaseCount + c.ReleaseGroupCount + c.SeriesCount + c.WorkCount
+}
+
+// CollectionListResult is a page of collections of an editor
+type CollectionListResult struct {
+	Count       int          `json:"collection-count"`
+	Offset      int          `json:"collection-offset"`
+	Collections []Collection `json:"collections"`
+}
+
+// CollectionContents is a page of the entities in a collection. Only the
+// list matching the entity type of the collection is filled.
+type CollectionContents struct {
+	Count         int            `json:"-"`
+	Offset        int            `json:"-"`
+	Areas         []Area         `json:"areas"`
+	Artists       []Artist       `json:"artists"`
+	Events        []Event        `json:"events"`
+	Instruments   []Instrument   `json:"instruments"`
+	Labels        []Label        `json:"labels"`
+	Places        []Place        `json:"places"`
+	Recordings    []Recording    `json:"recordings"`
+	Releases      []Release      `json:"releases"`
+	ReleaseGroups []ReleaseGroup `json:"release-groups"`
+	Series        []Series       `json:"series"`
+	Works         []Work         `json:"works"`
+}
+
+// UnmarshalJSON decodes a browse response, whose count and offset fields
+// are named after the type of the browsed entities
+func (cc *CollectionContents) UnmarshalJSON(data []byte) error {
+	type contents CollectionContents
+	if err := json.Unmarshal(data, (*contents)(cc)); err != nil {
+		return err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for key, value := range fields {
+		var err error
+		switch {
+		case strings.HasSuffix(key, "-count"):
+			err = json.Unmarshal(value, &cc.Count)
+		case strings.HasSuffix(key, "-offset"):
+			err = json.Unmarshal(value, &cc.Offset)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validCollectionEntityType reports whether collections can hold entities
+// of entityType
+func validCollectionEntityType(entityType string) bool {
+	_, ok := collectionPaths[entityType]
+	return ok && !strings.Contains(entityType, "_")
+}
+
+// authenticated reports whether the client sends credentials or OAuth tokens
+func (c *Client) authenticated() bool {
+	return c.username != "" || c.tokenSource != nil
+}
+
+// GetUserCollections retrieves a page of the collections of an editor. The
+// editor is the authenticated user unless set with WithEditor. Private
+// collections are only listed for the authenticated user. Use WithLimit and
+// WithOffset to page through them.
+func (c *Client) GetUserCollections(ctx context.Context, opts ...RequestOption) (*CollectionListResult, error) {
+	o := collectOptions(opts)
+	editor := o.editor
+	if editor == "" {
+		editor = c.username
+	}
+	if editor == "" {
+		return nil, errors.New("musicbrainz: listing collections requires WithEditor or WithCredentials")
+	}
+
+	params := url.Values{}
+	params.Set("editor", editor)
+	if c.authenticated() {
+		params.Set("inc", "user-collections")
+	}
+	if o.limit > 0 {
+		params.Set("limit", strconv.Itoa(o.limit))
+	}
+	if o.offset > 0 {
+		params.Set("offset", strconv.Itoa(o.offset))
+	}
+
+	var result CollectionListResult
+	if err := c.get(ctx, "collection", params, o.target(&result)); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetCollectionContents retrieves a page of the entities of type entityType,
+// such as "release" or "artist", in a collection. Private collections require
+// authentication as their editor. Use WithLimit and WithOffset to page through
+// them and WithIncludes to request subqueries.
+func (c *Client) GetCollectionContents(ctx context.Context, collectionID MBID, entityType string, opts ...RequestOption) (*CollectionContents, error) {
+	if !validCollectionEntityType(entityType) {
+		return nil, fmt.Errorf("musicbrainz: invalid collection entity type %q", entityType)
+	}
+
+	var result CollectionContents
+	if err := c.browse(ctx, entityType, "collection", collectionID, opts, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// collectionBatchSize is the number of entities added to or removed from a
+// collection per request, keeping URLs to a reasonable length
+const collectionBatchSize = 100
+
+// GetCollectionByID retrieves a collection, without its contents. Private
+// collections require authentication as their editor.
+func (c *Client) GetCollectionByID(ctx context.Context, id MBID) (*Collection, error) {
+	var collection Collection
+	if err := c.lookup(ctx, "collection", id, nil, &collection); err != nil {
+		return nil, err
+	}
+
+	return &collection, nil
+}
+
+// AddToCollection adds entities to a collection of the authenticated user.
+// The entities must be of the type the collection holds, which is looked up
+// first.
+func (c *Client) AddToCollection(ctx context.Context, collectionID MBID, ids ...MBID) error {
+	return c.editCollection(ctx, http.MethodPut, collectionID, ids)
+}
+
+// RemoveFromCollection removes entities from a collection of the
+// authenticated user
+func (c *Client) RemoveFromCollection(ctx context.Context, collectionID MBID, ids ...MBID) error {
+	return c.editCollection(ctx, http.MethodDelete, collectionID, ids)
+}
+
+// editCollection adds or removes entities from a collection, depending on
+// method
+func (c *Client) editCollection(ctx context.Context, method string, collectionID MBID, ids []MBID) error {
+	if !c.authenticated() {
+		return ErrUnauthenticated
+	}
+	for _, id := range ids {
+		if err := id.validate(); err != nil {
+			return err
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	collection, err := c.GetCollectionByID(ctx, collectionID)
+	if err != nil {
+		return err
+	}
+	plural, ok := collectionPaths[collection.EntityType]
+	if !ok {
+		return fmt.Errorf("musicbrainz: unsupported collection entity type %q", collection.EntityType)
+	}
+
+	for start := 0; start < len(ids); start += collectionBatchSize {
+		end := start + collectionBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		batch := make([]string, 0, end-start)
+		for _, id := range ids[start:end] {
+			batch = append(batch, string(id))
+		}
+		path := "collection/" + string(collectionID) + "/" + plural + "/" + strings.Join(batch, ";")
+		if err := c.send(ctx, method, path, nil, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// collectionPaths maps the entity types of collections to the path element
+// of their contents. Release group collections may report their type with an
+// underscore.
+var collectionPaths = map[string]string{
+	"area":          "areas",
+	"artist":        "artists",
+	"event":         "events",
+	"instrument":    "instruments",
+	"label":         "labels",
+	"place":         "places",
+	"recording":     "recordings",
+	"release":       "releases",
+	"release-group": "release-groups",
+	"release_group": "release-groups",
+	"series":        "series",
+	"work":          "works",
+}
@@ -0,0 +1,156 @@
+package musicbrainz
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ReleaseComparison reports how two releases, a and b, differ
+type ReleaseComparison struct {
+	// SameReleaseGroup reports whether the releases are editions of the same
+	// album
+	SameReleaseGroup bool
+	// Fields lists the release fields whose values differ
+	Fields []FieldDifference
+	// Tracks lists the positions whose tracks differ, including those
+	// present on one release only
+	Tracks []TrackDifference
+}
+
+// FieldDifference is a field, such as "country" or "barcode", with
+// different values on two releases
+type FieldDifference struct {
+	Field string
+	A, B  string
+}
+
+// TrackDifference is a position on two releases holding different tracks.
+// A or B is nil when the release has no track at that position.
+type TrackDifference struct {
+	DiscNumber  int
+	TrackNumber int
+	A, B        *Track
+}
+
+// Identical reports whether the releases differ in neither their fields nor
+// their tracklists
+func (c *ReleaseComparison) Identical() bool {
+	return len(c.Fields) == 0 && len(c.Tracks) == 0
+}
+
+// CompareReleases compares two releases, typically editions of an album,
+// field by field and track by track. Tracks differ when they are not the
+// same recording, have a different title or differ in length by more than
+// two seconds. Tracklists are only compared when both releases were
+// retrieved with the "recordings" include.
+func CompareReleases(a, b Release) *ReleaseComparison {
+	c := &ReleaseComparison{
+		SameReleaseGroup: a.ReleaseGroup.ID != "" && a.ReleaseGroup.ID == b.ReleaseGroup.ID,
+	}
+	fields := []struct {
+		name string
+		a, b string
+	}{
+		{"title", a.Title, b.Title},
+		{"status", string(a.Status), string(b.Status)},
+		{"date", a.Date.String(), b.Date.String()},
+		{"country", a.Country, b.Country},
+		{"barcode", a.Barcode, b.Barcode},
+		{"label", releaseLabels(&a), releaseLabels(&b)},
+		{"catalog-number", catalogNumbers(&a), catalogNumbers(&b)},
+		{"format", releaseFormat(&a), releaseFormat(&b)},
+		{"packaging", a.Packaging, b.Packaging},
+	}
+	for _, field := range fields {
+		if field.a != field.b {
+			c.Fields = append(c.Fields, FieldDifference{Field: field.name, A: field.a, B: field.b})
+		}
+	}
+
+	discs := len(a.Media)
+	if len(b.Media) > discs {
+		discs = len(b.Media)
+	}
+	for disc := 0; disc < discs; disc++ {
+		tracksA, tracksB := mediumTracks(a.Media, disc), mediumTracks(b.Media, disc)
+		count := len(tracksA)
+		if len(tracksB) > count {
+			count = len(tracksB)
+		}
+		for i := 0; i < count; i++ {
+			var trackA, trackB *Track
+			if i < len(tracksA) {
+				trackA = &tracksA[i]
+			}
+			if i < len(tracksB) {
+				trackB = &tracksB[i]
+			}
+			if !sameTrack(trackA, trackB) {
+				c.Tracks = append(c.Tracks, TrackDifference{DiscNumber: disc + 1, TrackNumber: i + 1, A: trackA, B: trackB})
+			}
+		}
+	}
+	return c
+}
+
+// sameTrack reports whether a and b are the same recording under the same
+// title and with a similar length
+func sameTrack(a, b *Track) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Recording.ID == b.Recording.ID && a.Title == b.Title && similarLength(a.Length, b.Length)
+}
+
+// mediumTracks returns the tracks of the medium at index i of media, if any
+func mediumTracks(media []Medium, i int) []Track {
+	if i < len(media) {
+		return media[i].Tracks
+	}
+	return nil
+}
+
+// releaseLabels returns the names of the labels of a release
+func releaseLabels(release *Release) string {
+	var labels []string
+	for _, info := range release.LabelInfo {
+		if info.Label != nil && !containsString(labels, info.Label.Name) {
+			labels = append(labels, info.Label.Name)
+		}
+	}
+	return strings.Join(labels, ", ")
+}
+
+// catalogNumbers returns the catalog numbers of a release
+func catalogNumbers(release *Release) string {
+	var numbers []string
+	for _, info := range release.LabelInfo {
+		if info.CatalogNumber != "" && !containsString(numbers, info.CatalogNumber) {
+			numbers = append(numbers, info.CatalogNumber)
+		}
+	}
+	return strings.Join(numbers, ", ")
+}
+
+// releaseFormat describes the media of a release as MusicBrainz does, such
+// as "2×CD" or "CD + DVD-Video"
+func releaseFormat(release *Release) string {
+	var formats []string
+	counts := make(map[string]int)
+	for _, medium := range release.Media {
+		format := medium.Format
+		if format == "" {
+			format = "(unknown)"
+		}
+		if counts[format] == 0 {
+			formats = append(formats, format)
+		}
+		counts[format]++
+	}
+	for i, format := range formats {
+		if counts[format] > 1 {
+			formats[i] = fmt.Sprintf("%d×%s", counts[format], format)
+		}
+	}
+	return strings.Join(formats, " + ")
+}
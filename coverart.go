@@ -0,0 +1,231 @@
+package musicbrainz
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+// CoverArtArchiveEndpoint represents the base URL of the Cover Art Archive API
+const CoverArtArchiveEndpoint = "https://coverartarchive.org/"
+
+// CoverArtClient retrieves release artwork from the Cover Art Archive. The
+// Cover Art Archive isn't subject to the MusicBrainz rate limit, so its
+// requests bypass the Client's rate limiter.
+type CoverArtClient struct {
+	client *Client
+}
+
+// CoverArt returns a CoverArtClient sharing the Client's configuration
+func (c *Client) CoverArt() *CoverArtClient {
+	return &CoverArtClient{client: c}
+}
+
+// CoverArtIndex represents the artwork of a release in the Cover Art Archive
+type CoverArtIndex struct {
+	Release string          `json:"release"`
+	Images  []CoverArtImage `json:"images"`
+}
+
+// CoverArtImage represents an image in the Cover Art Archive
+type CoverArtImage struct {
+	ID         json.Number `json:"id"`
+	Image      string      `json:"image"`
+	Thumbnails Thumbnails  `json:"thumbnails"`
+	Types      []string    `json:"types"`
+	Front      bool        `json:"front"`
+	Back       bool        `json:"back"`
+	Approved   bool        `json:"approved"`
+	Comment    string      `json:"comment"`
+	Edit       int         `json:"edit"`
+}
+
+// Thumbnails holds the URLs of the thumbnails of a Cover Art Archive image.
+// Small and Large are legacy names for the 250 and 500 pixel thumbnails.
+type Thumbnails struct {
+	Size250  string `json:"250"`
+	Size500  string `json:"500"`
+	Size1200 string `json:"1200"`
+	Small    string `json:"small"`
+	Large    string `json:"large"`
+}
+
+// ThumbnailSize selects the size of a Cover Art Archive thumbnail, in pixels
+type ThumbnailSize int
+
+const (
+	// ThumbnailOriginal selects the full-size image
+	ThumbnailOriginal ThumbnailSize = 0
+	// Thumbnail250 selects the 250 pixel thumbnail
+	Thumbnail250 ThumbnailSize = 250
+	// Thumbnail500 selects the 500 pixel thumbnail
+	Thumbnail500 ThumbnailSize = 500
+	// Thumbnail1200 selects the 1200 pixel thumbnail
+	Thumbnail1200 ThumbnailSize = 1200
+)
+
+// CoverImage is an image downloaded from the Cover Art Archive
+type CoverImage struct {
+	Data        []byte
+	ContentType string
+}
+
+// GetCoverArt retrieves the list of images of a release
+func (cc *CoverArtClient) GetCoverArt(ctx context.Context, releaseID MBID) (*CoverArtIndex, error) {
+	rawURL, err := cc.entityURL("release", releaseID)
+	if err != nil {
+		return nil, err
+	}
+	var index CoverArtIndex
+	if err := cc.client.getJSON(ctx, nil, rawURL, &index); err != nil {
+		return nil, err
+	}
+
+	return &index, nil
+}
+
+// GetReleaseGroupCoverArt retrieves the list of images chosen to represent a
+// release group, taken from one of its releases
+func (cc *CoverArtClient) GetReleaseGroupCoverArt(ctx context.Context, releaseGroupID MBID) (*CoverArtIndex, error) {
+	rawURL, err := cc.entityURL("release-group", releaseGroupID)
+	if err != nil {
+		return nil, err
+	}
+	var index CoverArtIndex
+	if err := cc.client.getJSON(ctx, nil, rawURL, &index); err != nil {
+		return nil, err
+	}
+
+	return &index, nil
+}
+
+// GetBestCoverArt retrieves the list of images of a release, falling back to
+// the artwork of its release group when the release itself has none, which
+// is common for reissues and regional editions
+func (cc *CoverArtClient) GetBestCoverArt(ctx context.Context, releaseID MBID) (*CoverArtIndex, error) {
+	index, err := cc.GetCoverArt(ctx, releaseID)
+	if err == nil && len(index.Images) > 0 {
+		return index, nil
+	}
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+
+	release, err := cc.client.GetReleaseByID(ctx, releaseID, WithIncludes("release-groups"))
+	if err != nil {
+		return nil, err
+	}
+	if release.ReleaseGroup.ID == "" {
+		return nil, ErrNotFound
+	}
+	return cc.GetReleaseGroupCoverArt(ctx, release.ReleaseGroup.ID)
+}
+
+// HasCoverArt reports whether a release has a front cover image, using a
+// HEAD request so that no image is downloaded
+func (cc *CoverArtClient) HasCoverArt(ctx context.Context, releaseID MBID) (bool, error) {
+	rawURL, err := cc.entityURL("release", releaseID)
+	if err != nil {
+		return false, err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodHead, rawURL+"/front", nil)
+	if err != nil {
+		return false, err
+	}
+
+	response, err := cc.client.do(ctx, nil, request)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if err := checkResponse(response); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// GetFrontCoverImage downloads the front cover image of a release. Pass
+// WithThumbnailSize to download a thumbnail instead of the full-size image.
+func (cc *CoverArtClient) GetFrontCoverImage(ctx context.Context, releaseID MBID, opts ...RequestOption) (*CoverImage, error) {
+	return cc.getImage(ctx, releaseID, "front", opts)
+}
+
+// GetBackCoverImage downloads the back cover image of a release. Pass
+// WithThumbnailSize to download a thumbnail instead of the full-size image.
+func (cc *CoverArtClient) GetBackCoverImage(ctx context.Context, releaseID MBID, opts ...RequestOption) (*CoverImage, error) {
+	return cc.getImage(ctx, releaseID, "back", opts)
+}
+
+// WriteFrontCoverImage streams the front cover image of a release into w,
+// without holding it in memory, and returns its content type
+func (cc *CoverArtClient) WriteFrontCoverImage(ctx context.Context, releaseID MBID, w io.Writer, opts ...RequestOption) (string, error) {
+	return cc.writeImage(ctx, releaseID, "front", w, opts)
+}
+
+// WriteBackCoverImage streams the back cover image of a release into w,
+// without holding it in memory, and returns its content type
+func (cc *CoverArtClient) WriteBackCoverImage(ctx context.Context, releaseID MBID, w io.Writer, opts ...RequestOption) (string, error) {
+	return cc.writeImage(ctx, releaseID, "back", w, opts)
+}
+
+// entityURL returns the Cover Art Archive URL of the release or release group
+// with the given MBID
+func (cc *CoverArtClient) entityURL(entity string, id MBID) (string, error) {
+	if err := id.validate(); err != nil {
+		return "", err
+	}
+	return cc.client.coverArtURL + entity + "/" + string(id), nil
+}
+
+func (cc *CoverArtClient) getImage(ctx context.Context, releaseID MBID, image string, opts []RequestOption) (*CoverImage, error) {
+	var buf bytes.Buffer
+	contentType, err := cc.writeImage(ctx, releaseID, image, &buf, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CoverImage{Data: buf.Bytes(), ContentType: contentType}, nil
+}
+
+// writeImage downloads the given image of a release into w, following the
+// redirect from the Cover Art Archive to the image file
+func (cc *CoverArtClient) writeImage(ctx context.Context, releaseID MBID, image string, w io.Writer, opts []RequestOption) (string, error) {
+	switch size := collectOptions(opts).thumbnailSize; size {
+	case ThumbnailOriginal:
+	case Thumbnail250, Thumbnail500, Thumbnail1200:
+		image += "-" + strconv.Itoa(int(size))
+	default:
+		return "", fmt.Errorf("musicbrainz: invalid thumbnail size %d", size)
+	}
+
+	rawURL, err := cc.entityURL("release", releaseID)
+	if err != nil {
+		return "", err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL+"/"+image, nil)
+	if err != nil {
+		return "", err
+	}
+
+	response, err := cc.client.do(ctx, nil, request)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+	if err := checkResponse(response); err != nil {
+		return "", err
+	}
+
+	if _, err := io.Copy(w, response.Body); err != nil {
+		return "", err
+	}
+	return response.Header.Get("Content-Type"), nil
+}
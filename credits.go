@@ -0,0 +1,109 @@
+package musicbrainz
+
+import "context"
+
+// Credit is a contribution of an artist to a recording or its work
+type Credit struct {
+	Artist Artist
+	// Name is the name the artist is credited under
+	Name string
+	// Role is the type of the relationship, such as "producer", "mix" or
+	// "instrument"
+	Role string
+	// Attributes qualify the role, such as "guitar" or "lead vocals" for a
+	// performer, or "co" and "executive" for a producer
+	Attributes []string
+}
+
+// RecordingCredits are the credits of a recording, as shown in liner notes
+type RecordingCredits struct {
+	// Performers holds the performers and their instruments or vocals, as
+	// well as orchestras and conductors
+	Performers []Credit
+	Producers  []Credit
+	// Engineers holds the engineering credits, from recording to mixing
+	// and mastering
+	Engineers []Credit
+	// Composers, Lyricists and Writers come from the works the recording
+	// is a performance of
+	Composers []Credit
+	Lyricists []Credit
+	Writers   []Credit
+}
+
+// engineerRoles are the recording relationship types credited as engineering
+var engineerRoles = map[string]bool{
+	"engineer": true, "audio": true, "sound": true, "recording": true,
+	"mix": true, "mastering": true, "editor": true, "programming": true,
+}
+
+// performerRoles are the recording relationship types credited as
+// performances
+var performerRoles = map[string]bool{
+	"performer": true, "instrument": true, "vocal": true,
+	"performing orchestra": true, "conductor": true, "chorus master": true,
+}
+
+// GetRecordingCredits retrieves the credits of a recording from its artist
+// relationships and those of the works it performs, which takes one request
+// for the recording and one per work
+func (c *Client) GetRecordingCredits(ctx context.Context, recordingID MBID) (*RecordingCredits, error) {
+	recording, err := c.GetRecordingByID(ctx, recordingID, WithIncludes("artist-rels", "work-rels"))
+	if err != nil {
+		return nil, err
+	}
+
+	var credits RecordingCredits
+	for i := range recording.Relations {
+		relation := &recording.Relations[i]
+		switch {
+		case relation.Artist == nil:
+		case performerRoles[relation.Type]:
+			credits.Performers = append(credits.Performers, newCredit(relation))
+		case relation.Type == "producer":
+			credits.Producers = append(credits.Producers, newCredit(relation))
+		case engineerRoles[relation.Type]:
+			credits.Engineers = append(credits.Engineers, newCredit(relation))
+		}
+	}
+
+	for i := range recording.Relations {
+		relation := &recording.Relations[i]
+		if relation.Work == nil || relation.Type != "performance" {
+			continue
+		}
+		work, err := c.GetWorkByID(ctx, relation.Work.ID, WithIncludes("artist-rels"))
+		if err != nil {
+			return nil, err
+		}
+		for j := range work.Relations {
+			workRelation := &work.Relations[j]
+			if workRelation.Artist == nil {
+				continue
+			}
+			switch workRelation.Type {
+			case "composer":
+				credits.Composers = append(credits.Composers, newCredit(workRelation))
+			case "lyricist":
+				credits.Lyricists = append(credits.Lyricists, newCredit(workRelation))
+			case "writer":
+				credits.Writers = append(credits.Writers, newCredit(workRelation))
+			}
+		}
+	}
+	return &credits, nil
+}
+
+// newCredit returns the credit of the artist targeted by relation
+func newCredit(relation *Relation) Credit {
+	name := relation.TargetCredit
+	if name == "" {
+		name = relation.Artist.Name
+	}
+	return Credit{
+		Artist:     *relation.Artist,
+		Name:       name,
+		Role:       relation.Type,
+		Attributes: relation.Attributes,
+	}
+}
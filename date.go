@@ -0,0 +1,167 @@
+package musicbrainz
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PartialDate represents a date whose month or day may be unknown, such as
+// "1969", "1969-07" or "1969-07-20". Unknown parts are zero.
+type PartialDate struct {
+	Year  int
+	Month int
+	Day   int
+}
+
+// ParsePartialDate parses a date in the YYYY, YYYY-MM or YYYY-MM-DD formats
+// used by MusicBrainz. Unknown parts, written as question marks, are zero.
+func ParsePartialDate(s string) (PartialDate, error) {
+	var d PartialDate
+	if s == "" {
+		return d, nil
+	}
+
+	parts := strings.Split(s, "-")
+	if len(parts) > 3 {
+		return d, fmt.Errorf("musicbrainz: invalid date %q", s)
+	}
+	fields := []*int{&d.Year, &d.Month, &d.Day}
+	for i, part := range parts {
+		if strings.Trim(part, "?") == "" {
+			continue
+		}
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return PartialDate{}, fmt.Errorf("musicbrainz: invalid date %q", s)
+		}
+		*fields[i] = n
+	}
+	if d.Month > 12 || d.Day > 31 {
+		return PartialDate{}, fmt.Errorf("musicbrainz: invalid date %q", s)
+	}
+	return d, nil
+}
+
+// IsZero reports whether the date is entirely unknown
+func (d PartialDate) IsZero() bool {
+	return d == PartialDate{}
+}
+
+// String formats the date as MusicBrainz does, leaving out unknown trailing
+// parts and writing an unknown year as "????"
+func (d PartialDate) String() string {
+	if d.IsZero() {
+		return ""
+	}
+	year := "????"
+	if d.Year != 0 {
+		year = fmt.Sprintf("%04d", d.Year)
+	}
+	switch {
+	case d.Month == 0 && d.Day == 0:
+		return year
+	case d.Day == 0:
+		return fmt.Sprintf("%s-%02d", year, d.Month)
+	}
+	return fmt.Sprintf("%s-%02d-%02d", year, d.Month, d.Day)
+}
+
+// Compare returns -1, 0 or +1 depending on whether d is before, equal to or
+// after other. Unknown parts sort before known ones, so "1969" is before
+// "1969-07". Check IsZero first when entirely unknown dates need special
+// treatment.
+func (d PartialDate) Compare(other PartialDate) int {
+	for _, pair := range [][2]int{{d.Year, other.Year}, {d.Month, other.Month}, {d.Day, other.Day}} {
+		if pair[0] < pair[1] {
+			return -1
+		}
+		if pair[0] > pair[1] {
+			return 1
+		}
+	}
+	return 0
+}
+
+// Before reports whether d is before other
+func (d PartialDate) Before(other PartialDate) bool {
+	return d.Compare(other) < 0
+}
+
+// UnmarshalJSON decodes a date string, treating null as an unknown date
+func (d *PartialDate) UnmarshalJSON(data []byte) error {
+	var s *string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == nil {
+		*d = PartialDate{}
+		return nil
+	}
+	parsed, err := ParsePartialDate(*s)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// MarshalJSON encodes the date as a string, or null if it is unknown
+func (d PartialDate) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(d.String())
+}
+
+// LifeSpan represents the period during which an entity, such as an artist
+// or an event, existed
+type LifeSpan struct {
+	Begin PartialDate `json:"begin"`
+	End   PartialDate `json:"end"`
+	Ended bool        `json:"ended"`
+}
+
+// Duration represents the length of a recording or track. MusicBrainz
+// stores lengths in milliseconds; a zero Duration means the length is
+// unknown.
+type Duration time.Duration
+
+// Std returns the length as a time.Duration
+func (d Duration) Std() time.Duration {
+	return time.Duration(d)
+}
+
+// IsZero reports whether the length is unknown
+func (d Duration) IsZero() bool {
+	return d == 0
+}
+
+func (d Duration) String() string {
+	return time.Duration(d).String()
+}
+
+// UnmarshalJSON decodes a length in milliseconds, treating null as an
+// unknown length
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var ms *float64
+	if err := json.Unmarshal(data, &ms); err != nil {
+		return err
+	}
+	if ms == nil {
+		*d = 0
+		return nil
+	}
+	*d = Duration(*ms * float64(time.Millisecond))
+	return nil
+}
+
+// MarshalJSON encodes the length in milliseconds, or null if it is unknown
+func (d Duration) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(time.Duration(d).Milliseconds())
+}
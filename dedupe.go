@@ -0,0 +1,71 @@
+package musicbrainz
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// dedupeTolerance is the largest length difference between recordings
+// considered the same by DedupeRecordings
+const dedupeTolerance = 2 * time.Second
+
+// RecordingGroup is a recording standing for near-duplicates of it, grouped
+// by DedupeRecordings
+type RecordingGroup struct {
+	Recording  Recording
+	Alternates []Recording
+}
+
+// DedupeRecordings groups recordings that share their title, once
+// normalized, and their artists, and whose lengths differ by at most two
+// seconds. A recording of unknown length joins the first group it otherwise
+// matches. Groups keep the order of their first recording, which represents
+// the group, so search results stay sorted by score.
+func DedupeRecordings(recordings []Recording) []RecordingGroup {
+	var groups []RecordingGroup
+	keys := make(map[string][]int)
+	for _, recording := range recordings {
+		key := recordingKey(&recording)
+		matched := false
+		for _, i := range keys[key] {
+			if similarLength(groups[i].Recording.Length, recording.Length) {
+				groups[i].Alternates = append(groups[i].Alternates, recording)
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			keys[key] = append(keys[key], len(groups))
+			groups = append(groups, RecordingGroup{Recording: recording})
+		}
+	}
+	return groups
+}
+
+// Deduplicated groups the recordings of the search result with
+// DedupeRecordings
+func (r *RecordingSearchResult) Deduplicated() []RecordingGroup {
+	return DedupeRecordings(r.Recordings)
+}
+
+// recordingKey identifies a recording by its normalized title and the sorted
+// MBIDs of its artists
+func recordingKey(recording *Recording) string {
+	ids := recording.ArtistCredit.IDs()
+	artists := make([]string, len(ids))
+	for i, id := range ids {
+		artists[i] = string(id)
+	}
+	sort.Strings(artists)
+	return normalizeName(recording.Title) + "\x00" + strings.Join(artists, ",")
+}
+
+// similarLength reports whether two lengths are within dedupeTolerance of
+// each other, or either is unknown
+func similarLength(a, b Duration) bool {
+	if a == 0 || b == 0 {
+		return true
+	}
+	return (a.Std() - b.Std()).Abs() <= dedupeTolerance
+}
@@ -0,0 +1,46 @@
+package musicbrainz
+
+import (
+	"context"
+	"net/url"
+)
+
+// Disc represents a CD disc ID and the table of contents it was computed from
+type Disc struct {
+	ID          string `json:"id"`
+	Sectors     int    `json:"sectors"`
+	OffsetCount int    `json:"offset-count"`
+	Offsets     []int  `json:"offsets"`
+}
+
+// LookupDiscID retrieves the releases, with their media and tracks, that
+// contain a disc with the given disc ID. Pass WithTOC to fall back to a fuzzy
+// lookup by table of contents when the disc ID is unknown, in which case the
+// disc ID may be empty.
+func (c *Client) LookupDiscID(ctx context.Context, discID string, opts ...RequestOption) ([]Release, error) {
+	o := collectOptions(append([]RequestOption{WithIncludes("artist-credits", "recordings")}, opts...))
+	if discID == "" {
+		discID = "-"
+	}
+
+	params := url.Values{}
+	if err := o.setIncludes(params, "release"); err != nil {
+		return nil, err
+	}
+	params.Set("cdstubs", "no")
+	if o.toc != "" {
+		params.Set("toc", o.toc)
+	}
+	if o.allMediaFormats {
+		params.Set("media-format", "all")
+	}
+
+	var result struct {
+		Releases []Release `json:"releases"`
+	}
+	if err := c.get(ctx, "discid/"+url.PathEscape(discID), params, o.target(&result)); err != nil {
+		return nil, err
+	}
+
+	return result.Releases, nil
+}
@@ -0,0 +1,148 @@
+// Package discid computes MusicBrainz disc IDs from the table of contents of
+// an audio CD, as libdiscid does, and looks up the matching releases.
+package discid
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/gcottom/musicbrainz"
+)
+
+// pregap is the offset, in sectors, of the first track of a disc without
+// hidden audio, as the two-second lead-in counts towards the offsets
+const pregap = 150
+
+// maxTracks is the number of tracks a CD can hold
+const maxTracks = 99
+
+// ErrInvalidTOC is returned when a table of contents is malformed
+var ErrInvalidTOC = errors.New("discid: invalid TOC")
+
+// discIDEncoding is the base64 alphabet of disc IDs, which replaces the
+// characters unsafe in URLs
+var discIDEncoding = base64.NewEncoding("ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789._").WithPadding('-')
+
+// TOC is the table of contents of an audio CD. Offsets are in sectors of
+// 1/75 second and include the 150 sectors of the lead-in, as reported by
+// cdparanoia, cdrdao or the drive itself.
+type TOC struct {
+	FirstTrack int
+	LastTrack  int
+	// LeadOut is the offset of the end of the last audio track
+	LeadOut int
+	// Offsets are the offsets of the tracks from FirstTrack to LastTrack
+	Offsets []int
+}
+
+// NewTOC returns the table of contents of a disc whose tracks start at the
+// given offsets, numbered from 1
+func NewTOC(offsets []int, leadOut int) (*TOC, error) {
+	toc := &TOC{FirstTrack: 1, LastTrack: len(offsets), LeadOut: leadOut, Offsets: offsets}
+	if err := toc.Validate(); err != nil {
+		return nil, err
+	}
+	return toc, nil
+}
+
+// ParseTOC parses a table of contents written as "first-track last-track
+// leadout-offset track-offsets...", as found in MusicBrainz URLs and
+// expected by musicbrainz.WithTOC
+func ParseTOC(s string) (*TOC, error) {
+	fields := strings.Fields(s)
+	if len(fields) < 4 {
+		return nil, fmt.Errorf("%w %q", ErrInvalidTOC, s)
+	}
+	values := make([]int, len(fields))
+	for i, field := range fields {
+		n, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, fmt.Errorf("%w %q", ErrInvalidTOC, s)
+		}
+		values[i] = n
+	}
+
+	toc := &TOC{FirstTrack: values[0], LastTrack: values[1], LeadOut: values[2], Offsets: values[3:]}
+	if err := toc.Validate(); err != nil {
+		return nil, err
+	}
+	return toc, nil
+}
+
+// Validate checks the track numbers and that the offsets increase up to the
+// lead-out
+func (t *TOC) Validate() error {
+	switch {
+	case t.FirstTrack < 1 || t.LastTrack > maxTracks || t.FirstTrack > t.LastTrack:
+		return fmt.Errorf("%w: tracks %d to %d", ErrInvalidTOC, t.FirstTrack, t.LastTrack)
+	case len(t.Offsets) != t.LastTrack-t.FirstTrack+1:
+		return fmt.Errorf("%w: %d offsets for %d tracks", ErrInvalidTOC, len(t.Offsets), t.LastTrack-t.FirstTrack+1)
+	}
+	previous := 0
+	for _, offset := range t.Offsets {
+		if offset <= previous {
+			return fmt.Errorf("%w: offsets must increase", ErrInvalidTOC)
+		}
+		previous = offset
+	}
+	if t.LeadOut <= previous {
+		return fmt.Errorf("%w: lead-out before the last track", ErrInvalidTOC)
+	}
+	return nil
+}
+
+// String formats the table of contents as ParseTOC parses it
+func (t *TOC) String() string {
+	parts := []string{strconv.Itoa(t.FirstTrack), strconv.Itoa(t.LastTrack), strconv.Itoa(t.LeadOut)}
+	for _, offset := range t.Offsets {
+		parts = append(parts, strconv.Itoa(offset))
+	}
+	return strings.Join(parts, " ")
+}
+
+// DiscID computes the MusicBrainz disc ID of the table of contents, a
+// 28-character string such as "Wn8eRBtfLDfM0qjYPdxrz.Zjs_U-"
+func (t *TOC) DiscID() string {
+	h := sha1.New()
+	fmt.Fprintf(h, "%02X%02X%08X", t.FirstTrack, t.LastTrack, t.LeadOut)
+	for track := 1; track <= maxTracks; track++ {
+		offset := 0
+		if i := track - t.FirstTrack; track >= t.FirstTrack && i < len(t.Offsets) {
+			offset = t.Offsets[i]
+		}
+		fmt.Fprintf(h, "%08X", offset)
+	}
+	return discIDEncoding.EncodeToString(h.Sum(nil))
+}
+
+// Duration returns the length of the audio on the disc, in seconds
+func (t *TOC) Duration() int {
+	return (t.LeadOut - pregap) / 75
+}
+
+// SubmissionURL returns the URL of the MusicBrainz page attaching the disc
+// ID to a release, for discs that Lookup finds no release for
+func (t *TOC) SubmissionURL() string {
+	params := url.Values{}
+	params.Set("id", t.DiscID())
+	params.Set("tracks", strconv.Itoa(t.LastTrack))
+	params.Set("toc", t.String())
+	return "https://musicbrainz.org/cdtoc/attach?" + params.Encode()
+}
+
+// Lookup retrieves the releases containing the disc, along with their media
+// and tracks, falling back to releases with a similar table of contents
+// when MusicBrainz doesn't know the disc ID
+func Lookup(ctx context.Context, client *musicbrainz.Client, toc *TOC, opts ...musicbrainz.RequestOption) ([]musicbrainz.Release, error) {
+	if err := toc.Validate(); err != nil {
+		return nil, err
+	}
+	opts = append([]musicbrainz.RequestOption{musicbrainz.WithTOC(toc.String())}, opts...)
+	return client.LookupDiscID(ctx, toc.DiscID(), opts...)
+}
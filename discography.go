@@ -0,0 +1,70 @@
+package musicbrainz
+
+import (
+	"context"
+	"sort"
+)
+
+// Discography gathers the release groups of an artist by kind, each list
+// sorted by first release date with undated release groups last
+type Discography struct {
+	// Albums holds the studio albums
+	Albums  []ReleaseGroup
+	Singles []ReleaseGroup
+	EPs     []ReleaseGroup
+	// Live holds the live release groups of every primary type
+	Live []ReleaseGroup
+	// Compilations holds the compilations of every primary type, including
+	// live compilations
+	Compilations []ReleaseGroup
+	// Other holds the remaining release groups, such as soundtracks, remix
+	// albums, broadcasts and spoken word
+	Other []ReleaseGroup
+}
+
+// GetArtistDiscography browses every release group of an artist and sorts
+// them into a Discography. Options such as WithType and WithIncludes are
+// passed on to the browse requests. Release groups have no status, so
+// WithStatus doesn't apply.
+func (c *Client) GetArtistDiscography(ctx context.Context, artistID MBID, opts ...RequestOption) (*Discography, error) {
+	releaseGroups, err := c.BrowseAllReleaseGroupsByArtist(ctx, artistID, opts...).Collect()
+	if err != nil {
+		return nil, err
+	}
+
+	var d Discography
+	for _, rg := range releaseGroups {
+		switch {
+		case rg.HasSecondaryType(ReleaseGroupSecondaryTypeCompilation):
+			d.Compilations = append(d.Compilations, rg)
+		case rg.HasSecondaryType(ReleaseGroupSecondaryTypeLive):
+			d.Live = append(d.Live, rg)
+		case rg.IsStudioAlbum():
+			d.Albums = append(d.Albums, rg)
+		case len(rg.SecondaryTypes) == 0 && rg.PrimaryType == ReleaseGroupTypeSingle:
+			d.Singles = append(d.Singles, rg)
+		case len(rg.SecondaryTypes) == 0 && rg.PrimaryType == ReleaseGroupTypeEP:
+			d.EPs = append(d.EPs, rg)
+		default:
+			d.Other = append(d.Other, rg)
+		}
+	}
+	for _, list := range [][]ReleaseGroup{d.Albums, d.Singles, d.EPs, d.Live, d.Compilations, d.Other} {
+		sortReleaseGroups(list)
+	}
+	return &d, nil
+}
+
+// sortReleaseGroups sorts release groups by first release date, then title
+func sortReleaseGroups(releaseGroups []ReleaseGroup) {
+	sort.SliceStable(releaseGroups, func(i, j int) bool {
+		a, b := releaseGroups[i].FirstReleaseDate, releaseGroups[j].FirstReleaseDate
+		if a.IsZero() != b.IsZero() {
+			return b.IsZero()
+		}
+		if c := a.Compare(b); c != 0 {
+			return c < 0
+		}
+		return releaseGroups[i].Title < releaseGroups[j].Title
+	})
+}
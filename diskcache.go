@@ -0,0 +1,122 @@
+package musicbrainz
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// DiskCache is a Cache storing values as files in a directory, so that they
+// survive restarts of long-running taggers and batch jobs. It is safe for
+// concurrent use, including by several processes sharing the directory.
+// Storing a file per key keeps the module free of dependencies and, unlike a
+// database file, needs no lock held by a single process; the
+// boltmusicbrainz module provides a cache stored in a BoltDB file instead.
+type DiskCache struct {
+	dir string
+}
+
+// NewDiskCache returns a DiskCache storing values in dir, creating it if
+// needed
+func NewDiskCache(dir string) (*DiskCache, error) {
+	if dir == "" {
+		return nil, errors.New("musicbrainz: empty cache directory")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &DiskCache{dir: dir}, nil
+}
+
+// path returns the file holding the value of key. Files are spread over
+// subdirectories to keep directories small.
+func (d *DiskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	name := hex.EncodeToString(sum[:])
+	return filepath.Join(d.dir, name[:2], name)
+}
+
+// Get returns the value stored for key, if any and not expired
+func (d *DiskCache) Get(key string) ([]byte, bool) {
+	// Expired files are left to Prune, as another process may replace them
+	// with a fresh value at any time
+	data, err := os.ReadFile(d.path(key))
+	if err != nil || len(data) < 8 || diskCacheExpired(data) {
+		return nil, false
+	}
+	return data[8:], true
+}
+
+// Set stores value for key. The file is written to a temporary file first
+// and renamed, so that readers never see a partially written value.
+func (d *DiskCache) Set(key string, value []byte, ttl time.Duration) {
+	var expires int64
+	if ttl > 0 {
+		expires = time.Now().Add(ttl).UnixNano()
+	}
+	data := make([]byte, 8, 8+len(value))
+	binary.BigEndian.PutUint64(data, uint64(expires))
+	data = append(data, value...)
+
+	path := d.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return
+	}
+	f, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return
+	}
+	_, err = f.Write(data)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), path)
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+}
+
+// Delete removes the value stored for key, if any
+func (d *DiskCache) Delete(key string) {
+	os.Remove(d.path(key))
+}
+
+// Prune removes the expired values from the directory. Get ignores expired
+// values but leaves them on disk until then.
+func (d *DiskCache) Prune() error {
+	return filepath.WalkDir(d.dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() || strings.HasPrefix(entry.Name(), ".tmp-") {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil || len(data) >= 8 && !diskCacheExpired(data) {
+			return nil
+		}
+		// Another process may have replaced the file since it was read, so
+		// it is moved aside and checked again, and linked back unless a
+		// newer value took its place meanwhile
+		pruned := filepath.Join(filepath.Dir(path), ".tmp-prune-"+entry.Name())
+		if os.Rename(path, pruned) != nil {
+			return nil
+		}
+		if data, err := os.ReadFile(pruned); err == nil && len(data) >= 8 && !diskCacheExpired(data) {
+			os.Link(pruned, path)
+		}
+		os.Remove(pruned)
+		return nil
+	})
+}
+
+// diskCacheExpired reports whether the file data holds an expired value
+func diskCacheExpired(data []byte) bool {
+	expires := int64(binary.BigEndian.Uint64(data))
+	return expires != 0 && time.Now().UnixNano() > expires
+}
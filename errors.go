@@ -0,0 +1,112 @@
+package musicbrainz
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+var (
+	// ErrNotFound is returned when the requested entity doesn't exist
+	ErrNotFound = errors.New("musicbrainz: not found")
+	// ErrRateLimited is returned when MusicBrainz rejects a request for
+	// exceeding the rate limit
+	ErrRateLimited = errors.New("musicbrainz: rate limited")
+	// ErrServiceUnavailable is returned when MusicBrainz is down or overloaded
+	ErrServiceUnavailable = errors.New("musicbrainz: service unavailable")
+	// ErrInvalidMBID is returned when an MBID is not a well-formed UUID. The
+	// request is not sent.
+	ErrInvalidMBID = errors.New("musicbrainz: invalid MBID")
+	// ErrNotCached is returned in offline mode when a response isn't cached
+	ErrNotCached = errors.New("musicbrainz: not cached")
+	// ErrResponseTooLarge is returned when a response exceeds the size set
+	// with WithMaxResponseSize
+	ErrResponseTooLarge = errors.New("musicbrainz: response too large")
+	// ErrUnauthenticated is returned when a request requiring
+	// authentication is made by a client configured with neither
+	// WithCredentials nor an OAuth token
+	ErrUnauthenticated = errors.New("musicbrainz: authentication required")
+	// ErrCircuitOpen is returned without sending the request while the
+	// circuit breaker set with WithCircuitBreaker is open
+	ErrCircuitOpen = errors.New("musicbrainz: circuit breaker open")
+)
+
+// APIError is returned when the MusicBrainz API responds with an error status.
+// It can be matched against ErrNotFound, ErrRateLimited and
+// ErrServiceUnavailable with errors.Is.
+type APIError struct {
+	StatusCode int    `json:"-"`
+	Message    string `json:"error"`
+	Help       string `json:"help"`
+	URL        string `json:"-"`
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("musicbrainz: %s: %d %s", e.URL, e.StatusCode, http.StatusText(e.StatusCode))
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	return msg
+}
+
+// Is reports whether the error matches one of the package's sentinel errors
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrRateLimited:
+		// MusicBrainz reports rate limiting as a 503 with an explanatory message
+		return e.StatusCode == http.StatusTooManyRequests ||
+			e.StatusCode == http.StatusServiceUnavailable && strings.Contains(e.Message, "rate limit")
+	case ErrServiceUnavailable:
+		return e.StatusCode == http.StatusServiceUnavailable
+	}
+	return false
+}
+
+// UnknownFieldsError is returned by clients created with WithStrictDecoding
+// when a response contains fields that the package's types don't model. Fields
+// holds their paths, such as "relations[].attribute-credits".
+type UnknownFieldsError struct {
+	URL    string
+	Fields []string
+}
+
+func (e *UnknownFieldsError) Error() string {
+	return fmt.Sprintf("musicbrainz: %s: unknown fields %s", e.URL, strings.Join(e.Fields, ", "))
+}
+
+// checkResponse returns an *APIError if response has an error status
+func checkResponse(response *http.Response) error {
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
+		return nil
+	}
+
+	apiErr := &APIError{StatusCode: response.StatusCode, URL: response.Request.URL.String()}
+	body, err := io.ReadAll(io.LimitReader(response.Body, 64<<10))
+	if err != nil {
+		return apiErr
+	}
+	// The body is informative only; a missing or malformed body still
+	// produces an error carrying the status code
+	if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && trimmed[0] == '<' {
+		// XML errors hold the message and the help text in <text> elements
+		var xmlErr struct {
+			Texts []string `xml:"text"`
+		}
+		if xml.Unmarshal(trimmed, &xmlErr) == nil && len(xmlErr.Texts) > 0 {
+			apiErr.Message = xmlErr.Texts[0]
+			if len(xmlErr.Texts) > 1 {
+				apiErr.Help = xmlErr.Texts[1]
+			}
+		}
+		return apiErr
+	}
+	json.Unmarshal(body, apiErr)
+	return apiErr
+}
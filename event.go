@@ -0,0 +1,70 @@
+package musicbrainz
+
+import (
+	"context"
+	"time"
+)
+
+// Event represents an event in the MusicBrainz database, such as a concert or
+// a festival
+type Event struct {
+	ID          MBID       `json:"id"`
+	RequestedID MBID       `json:"-"`
+	Name        string     `json:"name"`
+	Type        string     `json:"type"`
+	Time        string     `json:"time"`
+	Cancelled   bool       `json:"cancelled"`
+	Setlist     string     `json:"setlist"`
+	LifeSpan    LifeSpan   `json:"life-span"`
+	Disambig    string     `json:"disambiguation"`
+	Relations   []Relation `json:"relations"`
+	Tags        []Tag      `json:"tags"`
+	Genres      []Genre    `json:"genres"`
+	UserTags    []Tag      `json:"user-tags"`
+	UserGenres  []Genre    `json:"user-genres"`
+	Rating      *Rating    `json:"rating"`
+	UserRating  *Rating    `json:"user-rating"`
+	Score       int        `json:"score"`
+}
+
+// EventSearchResult is a page of events returned by a search
+type EventSearchResult struct {
+	Count   int       `json:"count"`
+	Offset  int       `json:"offset"`
+	Created time.Time `json:"created"`
+	Events  []Event   `json:"events"`
+}
+
+// SearchEvents searches for events by their name. Use WithOffset to page through
+// the results.
+func (c *Client) SearchEvents(ctx context.Context, name string, limit int, opts ...RequestOption) (*EventSearchResult, error) {
+	var result EventSearchResult
+	if err := c.search(ctx, "event", name, limit, opts, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// SearchAllEvents returns a Pager over the events matching a search, stopping
+// after maxResults results. A maxResults of zero pages through every result.
+func (c *Client) SearchAllEvents(ctx context.Context, name string, maxResults int, opts ...RequestOption) *Pager[Event] {
+	return searchPager(ctx, maxResults, func(ctx context.Context, limit, offset int) ([]Event, int, error) {
+		result, err := c.SearchEvents(ctx, name, limit, withOffset(opts, offset)...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.Events, result.Count, nil
+	})
+}
+
+// GetEventByID retrieves an event by its ID
+func (c *Client) GetEventByID(ctx context.Context, id MBID, opts ...RequestOption) (*Event, error) {
+	var event Event
+	if err := c.lookup(ctx, "event", id, opts, &event); err != nil {
+		return nil, err
+	}
+	event.RequestedID = id
+
+	return &event, nil
+}
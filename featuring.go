@@ -0,0 +1,94 @@
+package musicbrainz
+
+import "strings"
+
+// featuringMarkers are the join phrase words introducing featured artists
+var featuringMarkers = []string{"feat.", "feat ", "ft.", "ft ", "featuring", "f/"}
+
+// isFeaturing reports whether a join phrase introduces featured artists, as
+// in "A feat. B" or "A (ft. B)"
+func isFeaturing(joinPhrase string) bool {
+	phrase := strings.ToLower(joinPhrase) + " "
+	for _, marker := range featuringMarkers {
+		if strings.Contains(phrase, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// SplitFeatured splits an artist credit into its primary artists and the
+// artists featured on it, cutting at the first join phrase such as
+// " feat. ". "A & B feat. C" has the primary artists A and B and the
+// featured artist C. The join phrase of the last primary artist is cleared.
+func (credits ArtistCredits) SplitFeatured() (primary, featured ArtistCredits) {
+	for i, credit := range credits {
+		if isFeaturing(credit.JoinPhrase) && i < len(credits)-1 {
+			primary = append(ArtistCredits(nil), credits[:i+1]...)
+			primary[i].JoinPhrase = ""
+			return primary, credits[i+1:]
+		}
+	}
+	return credits, nil
+}
+
+// CreditFormat sets how FormatCredits renders an artist credit
+type CreditFormat struct {
+	// Separator joins the artists of a list but the last two, such as ", "
+	Separator string
+	// LastSeparator joins the last two artists of a list, such as " & "
+	LastSeparator string
+	// Featuring introduces the featured artists, such as " feat. "
+	Featuring string
+	// FeaturedInTitle moves the featured artists out of the credit, for
+	// rendering them in the title with FormatFeaturedTitle
+	FeaturedInTitle bool
+}
+
+var (
+	// CreditFormatStandard renders credits as "A, B & C feat. D & E"
+	CreditFormatStandard = CreditFormat{Separator: ", ", LastSeparator: " & ", Featuring: " feat. "}
+	// CreditFormatTitle renders credits as "A & B", leaving the featured
+	// artists to the title, as in "Title (feat. C)"
+	CreditFormatTitle = CreditFormat{Separator: ", ", LastSeparator: " & ", Featuring: " feat. ", FeaturedInTitle: true}
+)
+
+// FormatCredits renders an artist credit with the separators of format
+// rather than its own join phrases, for tagging conventions that expect
+// consistent separators
+func FormatCredits(credits ArtistCredits, format CreditFormat) string {
+	primary, featured := credits.SplitFeatured()
+	s := format.join(primary)
+	if len(featured) > 0 && !format.FeaturedInTitle {
+		s += format.Featuring + format.join(featured)
+	}
+	return s
+}
+
+// FormatFeaturedTitle appends the featured artists of credits to title, as
+// in "Title (feat. B)". The title is returned as is when no artist is
+// featured.
+func FormatFeaturedTitle(title string, credits ArtistCredits, format CreditFormat) string {
+	_, featured := credits.SplitFeatured()
+	if len(featured) == 0 {
+		return title
+	}
+	return title + " (" + strings.TrimSpace(format.Featuring) + " " + format.join(featured) + ")"
+}
+
+// join renders the names of credits with the separators of format
+func (format CreditFormat) join(credits ArtistCredits) string {
+	names := creditedNames(credits)
+	var b strings.Builder
+	for i, name := range names {
+		switch {
+		case i == 0:
+		case i == len(names)-1:
+			b.WriteString(format.LastSeparator)
+		default:
+			b.WriteString(format.Separator)
+		}
+		b.WriteString(name)
+	}
+	return b.String()
+}
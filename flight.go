@@ -0,0 +1,65 @@
+package musicbrainz
+
+import (
+	"context"
+	"sync"
+)
+
+// flightGroup coalesces concurrent calls sharing a key into a single call,
+// whose result is handed to every caller
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall
+}
+
+// flightCall is a call in progress or completed
+type flightCall struct {
+	done  chan struct{}
+	value any
+	err   error
+	// canceled is set when the call failed because the context of the
+	// caller making it was done, which says nothing of the other callers
+	canceled bool
+}
+
+// do calls fn with ctx and returns its results, unless a call with the same
+// key is already in progress, in which case it waits for that call and
+// returns its results instead. Waiting stops when ctx is done. A call
+// interrupted by the context of its caller is made again for the callers
+// still waiting.
+func (g *flightGroup) do(ctx context.Context, key string, fn func(ctx context.Context) (any, error)) (any, error) {
+	for {
+		g.mu.Lock()
+		call, ok := g.calls[key]
+		if !ok {
+			break
+		}
+		g.mu.Unlock()
+
+		select {
+		case <-call.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if !call.canceled || ctx.Err() != nil {
+			return call.value, call.err
+		}
+	}
+
+	if g.calls == nil {
+		g.calls = make(map[string]*flightCall)
+	}
+	call := &flightCall{done: make(chan struct{})}
+	g.calls[key] = call
+	g.mu.Unlock()
+
+	defer func() {
+		g.mu.Lock()
+		delete(g.calls, key)
+		g.mu.Unlock()
+		close(call.done)
+	}()
+	call.value, call.err = fn(ctx)
+	call.canceled = call.err != nil && ctx.Err() != nil
+	return call.value, call.err
+}
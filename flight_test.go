@@ -0,0 +1,151 @@
+package musicbrainz
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const flightArtistID = MBID("5b11f4ce-a62d-471e-81fc-a69a8278c7da")
+
+// newFlightClient returns a client of a server answering artist lookups
+// once release is closed, counting the requests it receives
+func newFlightClient(t *testing.T, release <-chan struct{}, requests *int32) *Client {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		select {
+		case <-release:
+		case <-r.Context().Done():
+			return
+		}
+		w.Write([]byte(`{"id":"5b11f4ce-a62d-471e-81fc-a69a8278c7da","name":"Nirvana"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := NewClient(WithBaseURL(server.URL), WithoutRateLimit(), WithRetry(1, 0, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+// waitRequests waits until the server received n requests
+func waitRequests(t *testing.T, requests *int32, n int32) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for atomic.LoadInt32(requests) < n {
+		if time.Now().After(deadline) {
+			t.Fatalf("got %d requests, want %d", atomic.LoadInt32(requests), n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestConcurrentLookupsAreCoalesced(t *testing.T) {
+	release := make(chan struct{})
+	var requests int32
+	c := newFlightClient(t, release, &requests)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			artist, err := c.GetArtistByID(context.Background(), flightArtistID)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if artist.Name != "Nirvana" || artist.RequestedID != flightArtistID {
+				t.Errorf("got %+v", artist)
+			}
+		}()
+	}
+	waitRequests(t, &requests, 1)
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if requests := atomic.LoadInt32(&requests); requests != 1 {
+		t.Errorf("got %d requests, want 1", requests)
+	}
+}
+
+func TestCoalescedLookupSurvivesFirstCallerCancel(t *testing.T) {
+	release := make(chan struct{})
+	var requests int32
+	c := newFlightClient(t, release, &requests)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	firstErr := make(chan error)
+	go func() {
+		_, err := c.GetArtistByID(ctx, flightArtistID)
+		firstErr <- err
+	}()
+	waitRequests(t, &requests, 1)
+
+	secondErr := make(chan error)
+	go func() {
+		artist, err := c.GetArtistByID(context.Background(), flightArtistID)
+		if err == nil && artist.Name != "Nirvana" {
+			err = errors.New("unexpected artist " + artist.Name)
+		}
+		secondErr <- err
+	}()
+	// Let the second caller join the request in flight
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+
+	if err := <-firstErr; !errors.Is(err, context.Canceled) {
+		t.Fatalf("first caller: got %v, want context.Canceled", err)
+	}
+	waitRequests(t, &requests, 2)
+	close(release)
+	if err := <-secondErr; err != nil {
+		t.Fatalf("second caller: %v", err)
+	}
+}
+
+func TestCoalescedLookupWaiterCancel(t *testing.T) {
+	release := make(chan struct{})
+	var requests int32
+	c := newFlightClient(t, release, &requests)
+
+	firstErr := make(chan error)
+	go func() {
+		_, err := c.GetArtistByID(context.Background(), flightArtistID)
+		firstErr <- err
+	}()
+	waitRequests(t, &requests, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	secondErr := make(chan error)
+	go func() {
+		_, err := c.GetArtistByID(ctx, flightArtistID)
+		secondErr <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+
+	select {
+	case err := <-secondErr:
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("second caller: got %v, want context.Canceled", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("second caller kept waiting after its context was canceled")
+	}
+	close(release)
+	if err := <-firstErr; err != nil {
+		t.Fatalf("first caller: %v", err)
+	}
+	if requests := atomic.LoadInt32(&requests); requests != 1 {
+		t.Errorf("got %d requests, want 1", requests)
+	}
+}
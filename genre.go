@@ -0,0 +1,56 @@
+package musicbrainz
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+// Genre represents a genre from the official MusicBrainz genre list. When
+// listed on an entity requested with the "genres" include, Count is the number
+// of users who tagged the entity with the genre.
+type Genre struct {
+	ID          MBID   `json:"id"`
+	RequestedID MBID   `json:"-"`
+	Name        string `json:"name"`
+	Disambig    string `json:"disambiguation"`
+	Count       int    `json:"count"`
+}
+
+// genrePageSize is the largest page the genre/all endpoint serves
+const genrePageSize = 100
+
+// GetGenreByID retrieves a genre by its ID
+func (c *Client) GetGenreByID(ctx context.Context, id MBID, opts ...RequestOption) (*Genre, error) {
+	var genre Genre
+	if err := c.lookup(ctx, "genre", id, opts, &genre); err != nil {
+		return nil, err
+	}
+	genre.RequestedID = id
+
+	return &genre, nil
+}
+
+// ListAllGenres retrieves the complete official genre list, paging through
+// the genre/all endpoint
+func (c *Client) ListAllGenres(ctx context.Context) ([]Genre, error) {
+	var genres []Genre
+	for {
+		params := url.Values{}
+		params.Set("limit", strconv.Itoa(genrePageSize))
+		params.Set("offset", strconv.Itoa(len(genres)))
+
+		var result struct {
+			Count  int     `json:"genre-count"`
+			Genres []Genre `json:"genres"`
+		}
+		if err := c.get(ctx, "genre/all", params, &result); err != nil {
+			return nil, err
+		}
+
+		genres = append(genres, result.Genres...)
+		if len(result.Genres) == 0 || len(genres) >= result.Count {
+			return genres, nil
+		}
+	}
+}
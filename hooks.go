@@ -0,0 +1,57 @@
+package musicbrainz
+
+import (
+	"net/http"
+	"time"
+)
+
+// Hooks are functions called around the HTTP requests of a Client, to add
+// logging, metrics, headers or fault injection without replacing its HTTP
+// client. Any of them may be nil. They are called from the goroutine
+// making the request, so hooks of a Client used concurrently must be safe
+// for concurrent use.
+type Hooks struct {
+	// OnRequest is called before each attempt of a request is sent, and may
+	// modify its headers
+	OnRequest func(request *http.Request)
+	// OnResponse is called after each attempt with its response, or the
+	// error that prevented one, and how long it took. The response body must
+	// be left unread.
+	OnResponse func(request *http.Request, response *http.Response, err error, elapsed time.Duration)
+	// OnRetry is called when a failed attempt is to be retried after delay.
+	// attempt is the number of the failed attempt, starting at 1.
+	OnRetry func(request *http.Request, response *http.Response, attempt int, delay time.Duration)
+}
+
+// WithHooks adds hooks called around every HTTP request of the client,
+// after those added before
+func WithHooks(hooks Hooks) Option {
+	return func(c *Client) error {
+		c.hooks = append(c.hooks, hooks)
+		return nil
+	}
+}
+
+func (c *Client) onRequest(request *http.Request) {
+	for _, hooks := range c.hooks {
+		if hooks.OnRequest != nil {
+			hooks.OnRequest(request)
+		}
+	}
+}
+
+func (c *Client) onResponse(request *http.Request, response *http.Response, err error, elapsed time.Duration) {
+	for _, hooks := range c.hooks {
+		if hooks.OnResponse != nil {
+			hooks.OnResponse(request, response, err, elapsed)
+		}
+	}
+}
+
+func (c *Client) onRetry(request *http.Request, response *http.Response, attempt int, delay time.Duration) {
+	for _, hooks := range c.hooks {
+		if hooks.OnRetry != nil {
+			hooks.OnRetry(request, response, attempt, delay)
+		}
+	}
+}
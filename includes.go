@@ -0,0 +1,81 @@
+package musicbrainz
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// relationIncludes request the relationships of an entity to entities of
+// each type. They are valid for every entity type.
+var relationIncludes = []string{
+	"area-rels", "artist-rels", "event-rels", "genre-rels", "instrument-rels",
+	"label-rels", "place-rels", "recording-rels", "release-rels",
+	"release-group-rels", "series-rels", "url-rels", "work-rels",
+}
+
+// validIncludes lists the subqueries each entity type accepts in the inc
+// parameter, besides relationIncludes
+var validIncludes = map[string][]string{
+	"area":          {"aliases", "annotation", "tags", "genres", "user-tags", "user-genres"},
+	"artist":        {"recordings", "releases", "release-groups", "works", "various-artists", "discids", "media", "isrcs", "aliases", "annotation", "tags", "ratings", "genres", "user-tags", "user-ratings", "user-genres"},
+	"event":         {"aliases", "annotation", "tags", "ratings", "genres", "user-tags", "user-ratings", "user-genres"},
+	"genre":         {"aliases"},
+	"instrument":    {"aliases", "annotation", "tags", "genres", "user-tags", "user-genres"},
+	"label":         {"releases", "discids", "media", "aliases", "annotation", "tags", "ratings", "genres", "user-tags", "user-ratings", "user-genres"},
+	"place":         {"aliases", "annotation", "tags", "genres", "user-tags", "user-genres"},
+	"recording":     {"artists", "releases", "release-groups", "discids", "media", "isrcs", "artist-credits", "aliases", "annotation", "tags", "ratings", "genres", "user-tags", "user-ratings", "user-genres"},
+	"release":       {"artists", "collections", "labels", "recordings", "release-groups", "discids", "media", "isrcs", "artist-credits", "aliases", "annotation", "tags", "genres", "user-tags", "user-genres", "recording-level-rels", "work-level-rels", "release-group-level-rels"},
+	"release-group": {"artists", "releases", "discids", "media", "artist-credits", "aliases", "annotation", "tags", "ratings", "genres", "user-tags", "user-ratings", "user-genres"},
+	"series":        {"aliases", "annotation", "tags", "genres", "user-tags", "user-genres"},
+	"work":          {"aliases", "annotation", "tags", "ratings", "genres", "user-tags", "user-ratings", "user-genres"},
+	"url":           {},
+}
+
+// validInclude reports whether include is a valid subquery for entity
+func validInclude(entity, include string) bool {
+	for _, inc := range relationIncludes {
+		if inc == include {
+			return true
+		}
+	}
+	for _, inc := range validIncludes[entity] {
+		if inc == include {
+			return true
+		}
+	}
+	return false
+}
+
+// setIncludes validates the requested includes against entity and sets the
+// inc parameter
+func (o requestOptions) setIncludes(params url.Values, entity string) error {
+	if len(o.includes) == 0 {
+		return nil
+	}
+	seen := make(map[string]bool, len(o.includes))
+	includes := make([]string, 0, len(o.includes))
+	for _, include := range o.includes {
+		if !validInclude(entity, include) {
+			return fmt.Errorf("musicbrainz: invalid include %q for %s", include, entity)
+		}
+		if !seen[include] {
+			seen[include] = true
+			includes = append(includes, include)
+		}
+	}
+	// Spaces encode as the literal "+" separators MusicBrainz expects
+	params.Set("inc", strings.Join(includes, " "))
+	return nil
+}
+
+// requiresAuthentication reports whether the requested includes return data
+// private to the authenticated user, such as "user-tags" or "user-ratings"
+func (o requestOptions) requiresAuthentication() bool {
+	for _, include := range o.includes {
+		if strings.HasPrefix(include, "user-") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,64 @@
+package musicbrainz
+
+import (
+	"context"
+	"time"
+)
+
+// Instrument represents a musical instrument in the MusicBrainz database
+type Instrument struct {
+	ID          MBID       `json:"id"`
+	RequestedID MBID       `json:"-"`
+	Name        string     `json:"name"`
+	Type        string     `json:"type"`
+	Description string     `json:"description"`
+	Disambig    string     `json:"disambiguation"`
+	Relations   []Relation `json:"relations"`
+	Tags        []Tag      `json:"tags"`
+	Genres      []Genre    `json:"genres"`
+	UserTags    []Tag      `json:"user-tags"`
+	UserGenres  []Genre    `json:"user-genres"`
+	Score       int        `json:"score"`
+}
+
+// InstrumentSearchResult is a page of instruments returned by a search
+type InstrumentSearchResult struct {
+	Count       int          `json:"count"`
+	Offset      int          `json:"offset"`
+	Created     time.Time    `json:"created"`
+	Instruments []Instrument `json:"instruments"`
+}
+
+// SearchInstruments searches for instruments by their name. Use WithOffset to page through
+// the results.
+func (c *Client) SearchInstruments(ctx context.Context, name string, limit int, opts ...RequestOption) (*InstrumentSearchResult, error) {
+	var result InstrumentSearchResult
+	if err := c.search(ctx, "instrument", name, limit, opts, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// SearchAllInstruments returns a Pager over the instruments matching a search, stopping
+// after maxResults results. A maxResults of zero pages through every result.
+func (c *Client) SearchAllInstruments(ctx context.Context, name string, maxResults int, opts ...RequestOption) *Pager[Instrument] {
+	return searchPager(ctx, maxResults, func(ctx context.Context, limit, offset int) ([]Instrument, int, error) {
+		result, err := c.SearchInstruments(ctx, name, limit, withOffset(opts, offset)...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.Instruments, result.Count, nil
+	})
+}
+
+// GetInstrumentByID retrieves an instrument by its ID
+func (c *Client) GetInstrumentByID(ctx context.Context, id MBID, opts ...RequestOption) (*Instrument, error) {
+	var instrument Instrument
+	if err := c.lookup(ctx, "instrument", id, opts, &instrument); err != nil {
+		return nil, err
+	}
+	instrument.RequestedID = id
+
+	return &instrument, nil
+}
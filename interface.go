@@ -0,0 +1,55 @@
+package musicbrainz
+
+import "context"
+
+// MBClient is the set of lookup, search and browse requests of a Client.
+// Programs depending on MBClient rather than *Client can substitute a mock
+// in their tests, or a decorator adding caching or metrics, without
+// wrapping every method of the package. The Pager methods are left out as
+// they are built on the page requests.
+type MBClient interface {
+	GetAreaByID(ctx context.Context, id MBID, opts ...RequestOption) (*Area, error)
+	GetArtistByID(ctx context.Context, id MBID, opts ...RequestOption) (*Artist, error)
+	GetEventByID(ctx context.Context, id MBID, opts ...RequestOption) (*Event, error)
+	GetGenreByID(ctx context.Context, id MBID, opts ...RequestOption) (*Genre, error)
+	GetInstrumentByID(ctx context.Context, id MBID, opts ...RequestOption) (*Instrument, error)
+	GetPlaceByID(ctx context.Context, id MBID, opts ...RequestOption) (*Place, error)
+	GetRecordingByID(ctx context.Context, id MBID, opts ...RequestOption) (*Recording, error)
+	GetReleaseByID(ctx context.Context, id MBID, opts ...RequestOption) (*Release, error)
+	GetReleaseGroupByID(ctx context.Context, id MBID, opts ...RequestOption) (*ReleaseGroup, error)
+	GetSeriesByID(ctx context.Context, id MBID, opts ...RequestOption) (*Series, error)
+	GetURLByID(ctx context.Context, id MBID, opts ...RequestOption) (*URL, error)
+	GetWorkByID(ctx context.Context, id MBID, opts ...RequestOption) (*Work, error)
+
+	GetRecordingsByISRC(ctx context.Context, isrc string, opts ...RequestOption) ([]Recording, error)
+	GetWorksByISWC(ctx context.Context, iswc string, opts ...RequestOption) ([]Work, error)
+	LookupDiscID(ctx context.Context, discID string, opts ...RequestOption) ([]Release, error)
+	LookupURLByResource(ctx context.Context, resource string, opts ...RequestOption) (*URL, error)
+
+	SearchAreas(ctx context.Context, name string, limit int, opts ...RequestOption) (*AreaSearchResult, error)
+	SearchArtists(ctx context.Context, name string, limit int, opts ...RequestOption) (*ArtistSearchResult, error)
+	SearchEvents(ctx context.Context, name string, limit int, opts ...RequestOption) (*EventSearchResult, error)
+	SearchInstruments(ctx context.Context, name string, limit int, opts ...RequestOption) (*InstrumentSearchResult, error)
+	SearchPlaces(ctx context.Context, name string, limit int, opts ...RequestOption) (*PlaceSearchResult, error)
+	SearchRecordings(ctx context.Context, title string, limit int, opts ...RequestOption) (*RecordingSearchResult, error)
+	SearchReleases(ctx context.Context, title string, limit int, opts ...RequestOption) (*ReleaseSearchResult, error)
+	SearchReleaseGroups(ctx context.Context, title string, limit int, opts ...RequestOption) (*ReleaseGroupSearchResult, error)
+	SearchSeries(ctx context.Context, name string, limit int, opts ...RequestOption) (*SeriesSearchResult, error)
+	SearchWorks(ctx context.Context, title string, limit int, opts ...RequestOption) (*WorkSearchResult, error)
+
+	BrowseReleasesByArtist(ctx context.Context, artistID MBID, opts ...RequestOption) (*ReleaseBrowseResult, error)
+	BrowseReleasesByLabel(ctx context.Context, labelID MBID, opts ...RequestOption) (*ReleaseBrowseResult, error)
+	BrowseReleasesByReleaseGroup(ctx context.Context, releaseGroupID MBID, opts ...RequestOption) (*ReleaseBrowseResult, error)
+	BrowseReleaseGroupsByArtist(ctx context.Context, artistID MBID, opts ...RequestOption) (*ReleaseGroupBrowseResult, error)
+	BrowseRecordingsByArtist(ctx context.Context, artistID MBID, opts ...RequestOption) (*RecordingBrowseResult, error)
+	BrowseRecordingsByRelease(ctx context.Context, releaseID MBID, opts ...RequestOption) (*RecordingBrowseResult, error)
+	BrowseArtistsByArea(ctx context.Context, areaID MBID, opts ...RequestOption) (*ArtistBrowseResult, error)
+	BrowseArtistsByRecording(ctx context.Context, recordingID MBID, opts ...RequestOption) (*ArtistBrowseResult, error)
+	BrowseArtistsByRelease(ctx context.Context, releaseID MBID, opts ...RequestOption) (*ArtistBrowseResult, error)
+	BrowseArtistsByWork(ctx context.Context, workID MBID, opts ...RequestOption) (*ArtistBrowseResult, error)
+	BrowseWorksByArtist(ctx context.Context, artistID MBID, opts ...RequestOption) (*WorkBrowseResult, error)
+	BrowseEventsByArtist(ctx context.Context, artistID MBID, opts ...RequestOption) (*EventBrowseResult, error)
+	BrowseLabelsByRelease(ctx context.Context, releaseID MBID, opts ...RequestOption) (*LabelBrowseResult, error)
+}
+
+var _ MBClient = (*Client)(nil)
@@ -0,0 +1,63 @@
+package musicbrainz
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// NormalizeISRC returns isrc in its canonical form, such as
+// "USRC17607839", removing hyphens and spaces and converting it to upper
+// case. It reports false if isrc isn't a well-formed ISRC.
+func NormalizeISRC(isrc string) (string, bool) {
+	isrc = strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(isrc))
+	if len(isrc) != 12 {
+		return "", false
+	}
+	for i := 0; i < len(isrc); i++ {
+		c := isrc[i]
+		letter := 'A' <= c && c <= 'Z'
+		digit := '0' <= c && c <= '9'
+		switch {
+		case i < 2 && !letter, // country code
+			i >= 2 && i < 5 && !letter && !digit, // registrant code
+			i >= 5 && !digit:                     // year and designation code
+			return "", false
+		}
+	}
+	return isrc, true
+}
+
+// SubmitISRCs adds ISRCs to recordings, in a single request made as the
+// authenticated user. ISRCs are validated and normalized first.
+func (c *Client) SubmitISRCs(ctx context.Context, isrcs map[MBID][]string) error {
+	ids := make([]MBID, 0, len(isrcs))
+	for id := range isrcs {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	var s submission
+	for _, id := range ids {
+		if len(isrcs[id]) == 0 {
+			continue
+		}
+		entity, err := s.entity("recording", id, []string{"recording"})
+		if err != nil {
+			return err
+		}
+		if entity.ISRCs == nil {
+			entity.ISRCs = &xmlISRCList{}
+		}
+		for _, isrc := range isrcs[id] {
+			normalized, ok := NormalizeISRC(isrc)
+			if !ok {
+				return fmt.Errorf("musicbrainz: invalid ISRC %q for recording %s", isrc, id)
+			}
+			entity.ISRCs.ISRCs = append(entity.ISRCs.ISRCs, xmlISRC{ID: normalized})
+		}
+		entity.ISRCs.Count = len(entity.ISRCs.ISRCs)
+	}
+	return c.submit(ctx, "recording", &s)
+}
@@ -0,0 +1,20 @@
+package musicbrainz
+
+// Label represents a record label in the MusicBrainz database
+type Label struct {
+	ID         MBID       `json:"id"`
+	Name       string     `json:"name"`
+	SortName   string     `json:"sort-name"`
+	Type       string     `json:"type"`
+	LabelCode  int        `json:"label-code"`
+	Country    string     `json:"country"`
+	LifeSpan   LifeSpan   `json:"life-span"`
+	Disambig   string     `json:"disambiguation"`
+	Relations  []Relation `json:"relations"`
+	Tags       []Tag      `json:"tags"`
+	Genres     []Genre    `json:"genres"`
+	UserTags   []Tag      `json:"user-tags"`
+	UserGenres []Genre    `json:"user-genres"`
+	Rating     *Rating    `json:"rating"`
+	UserRating *Rating    `json:"user-rating"`
+}
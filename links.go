@@ -0,0 +1,89 @@
+package musicbrainz
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ExternalLinks holds the links of an entity to well-known sites, taken from
+// its URL relationships. Each field holds the first matching link, or an
+// empty string if there is none.
+type ExternalLinks struct {
+	Spotify    string
+	AppleMusic string
+	Deezer     string
+	Bandcamp   string
+	YouTube    string
+	Discogs    string
+	Wikipedia  string
+	Wikidata   string
+	// Homepage is the official homepage of the entity
+	Homepage string
+	// Other holds the links to the remaining sites
+	Other []string
+}
+
+// GetExternalLinks retrieves the links of an entity of the given type, such
+// as "artist" or "release", to streaming services, stores and reference
+// sites. Links whose relationship has ended are left out.
+func (c *Client) GetExternalLinks(ctx context.Context, entityID MBID, entityType string) (*ExternalLinks, error) {
+	if _, ok := validIncludes[entityType]; !ok || entityType == "url" {
+		return nil, fmt.Errorf("musicbrainz: invalid entity type %q", entityType)
+	}
+
+	var entity struct {
+		Relations []Relation `json:"relations"`
+	}
+	if err := c.lookup(ctx, entityType, entityID, []RequestOption{WithIncludes("url-rels")}, &entity); err != nil {
+		return nil, err
+	}
+
+	var links ExternalLinks
+	for _, relation := range entity.Relations {
+		if relation.URL == nil || relation.Ended {
+			continue
+		}
+		links.add(relation.Type, relation.URL.Resource)
+	}
+	return &links, nil
+}
+
+// add files link, of the given relationship type, under the field matching
+// its host
+func (l *ExternalLinks) add(relationType, link string) {
+	u, err := url.Parse(link)
+	if err != nil {
+		return
+	}
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+
+	var field *string
+	switch {
+	case relationType == "official homepage":
+		field = &l.Homepage
+	case host == "open.spotify.com" || host == "spotify.com":
+		field = &l.Spotify
+	case host == "music.apple.com" || host == "itunes.apple.com":
+		field = &l.AppleMusic
+	case host == "deezer.com":
+		field = &l.Deezer
+	case host == "bandcamp.com" || strings.HasSuffix(host, ".bandcamp.com"):
+		field = &l.Bandcamp
+	case host == "youtube.com" || host == "music.youtube.com" || host == "youtu.be":
+		field = &l.YouTube
+	case host == "discogs.com":
+		field = &l.Discogs
+	case strings.HasSuffix(host, ".wikipedia.org"):
+		field = &l.Wikipedia
+	case host == "wikidata.org":
+		field = &l.Wikidata
+	default:
+		l.Other = append(l.Other, link)
+		return
+	}
+	if *field == "" {
+		*field = link
+	}
+}
@@ -0,0 +1,289 @@
+// Package listenbrainz submits listens to ListenBrainz. Listens carry the
+// MusicBrainz identifiers resolved with the musicbrainz package, which lets
+// ListenBrainz link them without guessing from names.
+package listenbrainz
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gcottom/musicbrainz"
+)
+
+// APIEndpoint is the base URL of the ListenBrainz API
+const APIEndpoint = "https://api.listenbrainz.org"
+
+// maxListensPerRequest is the largest number of listens ListenBrainz accepts
+// in a single import
+const maxListensPerRequest = 1000
+
+// Listen is a track listened to by a user
+type Listen struct {
+	// ListenedAt is when the user started listening. It is ignored for the
+	// track playing now.
+	ListenedAt  time.Time
+	ArtistName  string
+	TrackName   string
+	ReleaseName string
+
+	RecordingID musicbrainz.MBID
+	ReleaseID   musicbrainz.MBID
+	// TrackID is the MBID of the track on the release
+	TrackID     musicbrainz.MBID
+	ArtistIDs   []musicbrainz.MBID
+	Duration    time.Duration
+	TrackNumber int
+	ISRC        string
+}
+
+// ListenFromMetadata returns a listen of a track described by metadata, as
+// built by musicbrainz.BuildTrackMetadata
+func ListenFromMetadata(metadata *musicbrainz.TrackMetadata, listenedAt time.Time) Listen {
+	return Listen{
+		ListenedAt:  listenedAt,
+		ArtistName:  metadata.Artist,
+		TrackName:   metadata.Title,
+		ReleaseName: metadata.Album,
+		RecordingID: metadata.MusicBrainzTrackID,
+		ReleaseID:   metadata.MusicBrainzAlbumID,
+		TrackID:     metadata.MusicBrainzReleaseTrackID,
+		ArtistIDs:   metadata.MusicBrainzArtistIDs,
+		TrackNumber: metadata.TrackNumber,
+		ISRC:        metadata.ISRC,
+	}
+}
+
+// Error is returned when the ListenBrainz API responds with an error status
+type Error struct {
+	StatusCode int    `json:"code"`
+	Message    string `json:"error"`
+	// ResetIn is how long to wait before retrying a rate limited request
+	ResetIn time.Duration `json:"-"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("listenbrainz: %d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Message)
+}
+
+// Client is a ListenBrainz API client authenticated with the token of a
+// user. A Client is safe for concurrent use by multiple goroutines.
+type Client struct {
+	token         string
+	httpClient    *http.Client
+	baseURL       string
+	clientName    string
+	clientVersion string
+}
+
+// Option configures a Client
+type Option func(*Client) error
+
+// NewClient returns a new Client submitting listens for the user owning
+// token, found on their ListenBrainz settings page
+func NewClient(token string, opts ...Option) (*Client, error) {
+	if token == "" {
+		return nil, errors.New("listenbrainz: user token required")
+	}
+	c := &Client{
+		token:      token,
+		httpClient: http.DefaultClient,
+		baseURL:    APIEndpoint,
+		clientName: "gcottom-musicbrainz",
+	}
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// WithHTTPClient sets the HTTP client used for ListenBrainz requests
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) error {
+		if httpClient == nil {
+			return errors.New("listenbrainz: nil HTTP client")
+		}
+		c.httpClient = httpClient
+		return nil
+	}
+}
+
+// WithBaseURL sets the base URL of the API, such as that of a test server
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) error {
+		if _, err := url.Parse(baseURL); err != nil {
+			return fmt.Errorf("listenbrainz: invalid base URL: %w", err)
+		}
+		c.baseURL = strings.TrimSuffix(baseURL, "/")
+		return nil
+	}
+}
+
+// WithClientName sets the name and version of the application submitting
+// listens, shown to the user by ListenBrainz
+func WithClientName(name, version string) Option {
+	return func(c *Client) error {
+		c.clientName = name
+		c.clientVersion = version
+		return nil
+	}
+}
+
+// SubmitListen submits a listen the user has finished
+func (c *Client) SubmitListen(ctx context.Context, listen Listen) error {
+	return c.submit(ctx, "single", []Listen{listen})
+}
+
+// SubmitPlayingNow sets the track the user is listening to. ListenBrainz
+// keeps it until the track would have ended, without recording a listen.
+func (c *Client) SubmitPlayingNow(ctx context.Context, listen Listen) error {
+	return c.submit(ctx, "playing_now", []Listen{listen})
+}
+
+// ImportListens submits listens from the history of the user, such as those
+// recorded while offline, in batches of up to 1000
+func (c *Client) ImportListens(ctx context.Context, listens []Listen) error {
+	for start := 0; start < len(listens); start += maxListensPerRequest {
+		end := start + maxListensPerRequest
+		if end > len(listens) {
+			end = len(listens)
+		}
+		if err := c.submit(ctx, "import", listens[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateToken checks the token of the client and returns the name of the
+// user owning it
+func (c *Client) ValidateToken(ctx context.Context) (string, error) {
+	var result struct {
+		Valid    bool   `json:"valid"`
+		UserName string `json:"user_name"`
+		Message  string `json:"message"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/1/validate-token", nil, &result); err != nil {
+		return "", err
+	}
+	if !result.Valid {
+		return "", &Error{StatusCode: http.StatusUnauthorized, Message: result.Message}
+	}
+	return result.UserName, nil
+}
+
+type listenPayload struct {
+	ListenedAt    int64         `json:"listened_at,omitempty"`
+	TrackMetadata trackMetadata `json:"track_metadata"`
+}
+
+type trackMetadata struct {
+	ArtistName     string         `json:"artist_name"`
+	TrackName      string         `json:"track_name"`
+	ReleaseName    string         `json:"release_name,omitempty"`
+	AdditionalInfo additionalInfo `json:"additional_info"`
+}
+
+type additionalInfo struct {
+	RecordingMBID           musicbrainz.MBID   `json:"recording_mbid,omitempty"`
+	ReleaseMBID             musicbrainz.MBID   `json:"release_mbid,omitempty"`
+	TrackMBID               musicbrainz.MBID   `json:"track_mbid,omitempty"`
+	ArtistMBIDs             []musicbrainz.MBID `json:"artist_mbids,omitempty"`
+	DurationMS              int64              `json:"duration_ms,omitempty"`
+	TrackNumber             int                `json:"tracknumber,omitempty"`
+	ISRC                    string             `json:"isrc,omitempty"`
+	SubmissionClient        string             `json:"submission_client,omitempty"`
+	SubmissionClientVersion string             `json:"submission_client_version,omitempty"`
+}
+
+// submit posts listens of the given listen type
+func (c *Client) submit(ctx context.Context, listenType string, listens []Listen) error {
+	payload := make([]listenPayload, len(listens))
+	for i, listen := range listens {
+		if listen.ArtistName == "" || listen.TrackName == "" {
+			return errors.New("listenbrainz: listens require an artist and a track name")
+		}
+		p := listenPayload{
+			TrackMetadata: trackMetadata{
+				ArtistName:  listen.ArtistName,
+				TrackName:   listen.TrackName,
+				ReleaseName: listen.ReleaseName,
+				AdditionalInfo: additionalInfo{
+					RecordingMBID:           listen.RecordingID,
+					ReleaseMBID:             listen.ReleaseID,
+					TrackMBID:               listen.TrackID,
+					ArtistMBIDs:             listen.ArtistIDs,
+					DurationMS:              listen.Duration.Milliseconds(),
+					TrackNumber:             listen.TrackNumber,
+					ISRC:                    listen.ISRC,
+					SubmissionClient:        c.clientName,
+					SubmissionClientVersion: c.clientVersion,
+				},
+			},
+		}
+		if listenType != "playing_now" {
+			if listen.ListenedAt.IsZero() {
+				return errors.New("listenbrainz: listens require a listening time")
+			}
+			p.ListenedAt = listen.ListenedAt.Unix()
+		}
+		payload[i] = p
+	}
+
+	body, err := json.Marshal(struct {
+		ListenType string          `json:"listen_type"`
+		Payload    []listenPayload `json:"payload"`
+	}{listenType, payload})
+	if err != nil {
+		return err
+	}
+	return c.do(ctx, http.MethodPost, "/1/submit-listens", body, nil)
+}
+
+// do sends an authenticated request and decodes the response into v, unless
+// v is nil
+func (c *Client) do(ctx context.Context, method, path string, body []byte, v any) error {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	request, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reader)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Authorization", "Token "+c.token)
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		apiErr := &Error{StatusCode: response.StatusCode}
+		data, _ := io.ReadAll(io.LimitReader(response.Body, 64<<10))
+		json.Unmarshal(data, apiErr)
+		apiErr.StatusCode = response.StatusCode
+		if seconds, err := strconv.Atoi(response.Header.Get("X-RateLimit-Reset-In")); err == nil {
+			apiErr.ResetIn = time.Duration(seconds) * time.Second
+		}
+		return apiErr
+	}
+	if v == nil {
+		return nil
+	}
+	return json.NewDecoder(io.LimitReader(response.Body, 1<<20)).Decode(v)
+}
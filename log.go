@@ -0,0 +1,105 @@
+package musicbrainz
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// WithLogger logs the requests of the client at debug level: their URL,
+// status code and duration, as well as retries and rate limit waits. Query
+// values other than the standard API parameters are redacted, as searches
+// may hold personal data such as the file names of a user's library.
+func WithLogger(logger *slog.Logger) Option {
+	return func(c *Client) error {
+		c.logger = logger
+		return nil
+	}
+}
+
+// loggedParams are the query parameters logged unredacted
+var loggedParams = map[string]bool{
+	"fmt": true, "inc": true, "limit": true, "offset": true, "status": true,
+	"type": true, "dismax": true, "cdstubs": true, "media-format": true,
+	"client": true,
+}
+
+// redactURL returns u with the values of its query parameters other than
+// loggedParams replaced
+func redactURL(u *url.URL) string {
+	query := u.Query()
+	if len(query) == 0 {
+		return u.String()
+	}
+	for key, values := range query {
+		if loggedParams[key] {
+			continue
+		}
+		for i := range values {
+			values[i] = "REDACTED"
+		}
+	}
+	redacted := *u
+	redacted.RawQuery = query.Encode()
+	return redacted.String()
+}
+
+// redactError returns err with the URL it reports redacted as by redactURL,
+// as the errors of an HTTP client quote the URL requested
+func redactError(err error) error {
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		return err
+	}
+	redacted := *urlErr
+	if u, parseErr := url.Parse(urlErr.URL); parseErr == nil {
+		redacted.URL = redactURL(u)
+	} else {
+		redacted.URL = "REDACTED"
+	}
+	return &redacted
+}
+
+// logWait logs a rate limit wait, if long enough to be noticed
+func (c *Client) logWait(ctx context.Context, request *http.Request, waited time.Duration) {
+	if c.logger == nil || waited < time.Millisecond {
+		return
+	}
+	c.logger.DebugContext(ctx, "musicbrainz: rate limit wait",
+		slog.String("url", redactURL(request.URL)),
+		slog.Duration("wait", waited))
+}
+
+// logResponse logs an attempt of a request
+func (c *Client) logResponse(ctx context.Context, request *http.Request, response *http.Response, err error, attempt int, elapsed time.Duration) {
+	if c.logger == nil {
+		return
+	}
+	attrs := []any{
+		slog.String("method", request.Method),
+		slog.String("url", redactURL(request.URL)),
+		slog.Int("attempt", attempt),
+		slog.Duration("duration", elapsed),
+	}
+	if err != nil {
+		attrs = append(attrs, slog.Any("error", redactError(err)))
+	} else {
+		attrs = append(attrs, slog.Int("status", response.StatusCode))
+	}
+	c.logger.DebugContext(ctx, "musicbrainz: request", attrs...)
+}
+
+// logRetry logs a retry of a request
+func (c *Client) logRetry(ctx context.Context, request *http.Request, response *http.Response, attempt int, delay time.Duration) {
+	if c.logger == nil {
+		return
+	}
+	c.logger.DebugContext(ctx, "musicbrainz: retry",
+		slog.String("url", redactURL(request.URL)),
+		slog.Int("status", response.StatusCode),
+		slog.Int("attempt", attempt),
+		slog.Duration("delay", delay))
+}
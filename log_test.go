@@ -0,0 +1,62 @@
+package musicbrainz
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newLoggedClient(t *testing.T, baseURL string, log *bytes.Buffer) *Client {
+	t.Helper()
+	logger := slog.New(slog.NewTextHandler(log, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	c, err := NewClient(WithBaseURL(baseURL), WithoutRateLimit(), WithRetry(1, 0, 0), WithLogger(logger))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestLogRedactsQuery(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"count":0,"offset":0,"artists":[]}`))
+	}))
+	defer server.Close()
+
+	var log bytes.Buffer
+	c := newLoggedClient(t, server.URL, &log)
+	if _, err := c.SearchArtists(context.Background(), "secretvalue", 5); err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Contains(log.String(), "secretvalue") {
+		t.Errorf("log contains the query:\n%s", log.String())
+	}
+	if !strings.Contains(log.String(), "limit=5") {
+		t.Errorf("log misses the standard parameters:\n%s", log.String())
+	}
+}
+
+func TestLogRedactsTransportErrors(t *testing.T) {
+	// A closed server makes the request fail with a *url.Error quoting the
+	// requested URL
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	var log bytes.Buffer
+	c := newLoggedClient(t, server.URL, &log)
+	_, err := c.SearchArtists(context.Background(), "secretvalue", 5)
+	if err == nil {
+		t.Fatal("search of a closed server succeeded")
+	}
+
+	if !strings.Contains(log.String(), "error=") {
+		t.Fatalf("error not logged:\n%s", log.String())
+	}
+	if strings.Contains(log.String(), "secretvalue") {
+		t.Errorf("log contains the query:\n%s", log.String())
+	}
+}
@@ -0,0 +1,182 @@
+package musicbrainz
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// matchCandidates is the number of search results FindBestRecording scores
+const matchCandidates = 25
+
+// durationTolerance is the length difference at which a candidate recording
+// stops scoring for its duration
+const durationTolerance = 10 * time.Second
+
+// RecordingMatch is a recording matched by FindBestRecording
+type RecordingMatch struct {
+	Recording Recording
+	// Release is the preferred release of the recording among the releases
+	// listed by the search, official ones first, or nil if none is listed
+	Release *Release
+	// Confidence ranges from 0 to 1, 1 being a perfect match on every
+	// criterion
+	Confidence float64
+}
+
+// FindBestRecording searches for the recording best matching a title and an
+// artist name and returns it along with a confidence value. Candidates are
+// scored on the search score, the similarity of their title and artist
+// credit, their length when WithDuration is given, and whether they were
+// released officially. It returns ErrNotFound if the search has no results.
+func (c *Client) FindBestRecording(ctx context.Context, title, artist string, opts ...RequestOption) (*RecordingMatch, error) {
+	o := collectOptions(opts)
+	query := RecordingQuery{Recording: title, Artist: artist}.String()
+	result, err := c.SearchRecordings(ctx, query, matchCandidates, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var best *RecordingMatch
+	for i := range result.Recordings {
+		recording := &result.Recordings[i]
+		match := &RecordingMatch{
+			Recording:  *recording,
+			Release:    preferredRelease(recording.Releases),
+			Confidence: scoreRecording(recording, title, artist, o.duration),
+		}
+		if best == nil || match.Confidence > best.Confidence {
+			best = match
+		}
+	}
+	if best == nil {
+		return nil, ErrNotFound
+	}
+	return best, nil
+}
+
+// scoreRecording rates how well recording matches title, artist and, if not
+// zero, duration
+func scoreRecording(recording *Recording, title, artist string, duration time.Duration) float64 {
+	var score, weight float64
+	add := func(value, w float64) {
+		score += value * w
+		weight += w
+	}
+
+	add(float64(recording.Score)/100, 0.15)
+	add(similarity(title, recording.Title), 0.35)
+	add(creditSimilarity(artist, recording.ArtistCredit), 0.3)
+	if duration > 0 && recording.Length > 0 {
+		delta := (recording.Length.Std() - duration).Abs()
+		if delta > durationTolerance {
+			delta = durationTolerance
+		}
+		add(1-float64(delta)/float64(durationTolerance), 0.15)
+	}
+	official := 0.0
+	for i := range recording.Releases {
+		if recording.Releases[i].IsOfficial() {
+			official = 1
+			break
+		}
+	}
+	add(official, 0.05)
+	return score / weight
+}
+
+// creditSimilarity returns the similarity of name to an artist credit, as a
+// whole or to any of the credited artists
+func creditSimilarity(name string, credit ArtistCredits) float64 {
+	best := similarity(name, credit.String())
+	for _, c := range credit {
+		for _, candidate := range []string{c.Name, c.Artist.Name} {
+			if s := similarity(name, candidate); s > best {
+				best = s
+			}
+		}
+	}
+	return best
+}
+
+// preferredRelease returns the first official release of releases, or the
+// first release if none is official
+func preferredRelease(releases []Release) *Release {
+	for i := range releases {
+		if releases[i].IsOfficial() {
+			return &releases[i]
+		}
+	}
+	if len(releases) > 0 {
+		return &releases[0]
+	}
+	return nil
+}
+
+// similarity returns how similar two names are, from 0 to 1, once
+// normalized with normalizeName. It is based on their edit distance.
+func similarity(a, b string) float64 {
+	x, y := []rune(normalizeName(a)), []rune(normalizeName(b))
+	longest := len(x)
+	if len(y) > longest {
+		longest = len(y)
+	}
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(editDistance(x, y))/float64(longest)
+}
+
+// normalizeName lowercases name, spells out ampersands and removes
+// punctuation, so that names differing only by their typography compare
+// equal
+func normalizeName(name string) string {
+	name = strings.ReplaceAll(strings.ToLower(name), "&", " and ")
+	var b strings.Builder
+	space := false
+	for _, r := range name {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if space && b.Len() > 0 {
+				b.WriteByte(' ')
+			}
+			space = false
+			b.WriteRune(r)
+		case unicode.IsSpace(r) || r == '-' || r == '/':
+			space = true
+		}
+	}
+	return b.String()
+}
+
+// editDistance returns the Levenshtein distance between a and b
+func editDistance(a, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = minInt(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+func minInt(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
@@ -0,0 +1,161 @@
+// Package mbdump reads the MusicBrainz JSON data dumps, published at
+// https://data.metabrainz.org/pub/musicbrainz/data/json-dumps/, for bulk
+// matching without the API. Each dump is an xz-compressed tar archive, such
+// as artist.tar.xz, holding a file of one JSON document per line in the
+// format of the API. Decompress archives with xz, or a Go xz package, before
+// passing them to Open.
+package mbdump
+
+import (
+	"archive/tar"
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/gcottom/musicbrainz"
+)
+
+// maxDocumentSize is the size of the largest document a Reader accepts. The
+// largest releases, with every track and relationship, weigh a few megabytes.
+const maxDocumentSize = 64 << 20
+
+// Open returns the dump of the given entity type, such as "artist" or
+// "release-group", from an uncompressed dump archive. The archive is read
+// up to the dump, which is then streamed from it.
+func Open(archive io.Reader, entity string) (io.Reader, error) {
+	tr := tar.NewReader(archive)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil, fmt.Errorf("mbdump: no %s dump in archive", entity)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Name == "mbdump/"+entity {
+			return tr, nil
+		}
+	}
+}
+
+// Reader reads the documents of a dump one at a time, in the manner of
+// musicbrainz.Pager
+type Reader[T any] struct {
+	r    *bufio.Reader
+	raw  json.RawMessage
+	item T
+	line int
+	err  error
+}
+
+// NewReader returns a Reader decoding the documents of dump into values of
+// type T, such as musicbrainz.Artist
+func NewReader[T any](dump io.Reader) *Reader[T] {
+	return &Reader[T]{r: bufio.NewReaderSize(dump, 1<<20)}
+}
+
+// NewArtistReader returns a Reader over an artist dump
+func NewArtistReader(dump io.Reader) *Reader[musicbrainz.Artist] {
+	return NewReader[musicbrainz.Artist](dump)
+}
+
+// NewReleaseReader returns a Reader over a release dump
+func NewReleaseReader(dump io.Reader) *Reader[musicbrainz.Release] {
+	return NewReader[musicbrainz.Release](dump)
+}
+
+// NewRecordingReader returns a Reader over a recording dump
+func NewRecordingReader(dump io.Reader) *Reader[musicbrainz.Recording] {
+	return NewReader[musicbrainz.Recording](dump)
+}
+
+// Next advances to the next document. It returns false at the end of the
+// dump or when an error occurred.
+func (r *Reader[T]) Next() bool {
+	if r.err != nil {
+		return false
+	}
+	line, err := r.readLine()
+	if err != nil {
+		if err != io.EOF {
+			r.err = err
+		}
+		return false
+	}
+	r.line++
+
+	var item T
+	if err := json.Unmarshal(line, &item); err != nil {
+		r.err = fmt.Errorf("mbdump: line %d: %w", r.line, err)
+		return false
+	}
+	r.raw, r.item = line, item
+	return true
+}
+
+// readLine returns the next non-empty line
+func (r *Reader[T]) readLine() ([]byte, error) {
+	for {
+		var line []byte
+		for {
+			chunk, isPrefix, err := r.r.ReadLine()
+			if err != nil {
+				if err == io.EOF && len(line) > 0 {
+					break
+				}
+				return nil, err
+			}
+			line = append(line, chunk...)
+			if len(line) > maxDocumentSize {
+				return nil, fmt.Errorf("mbdump: line %d: document too large", r.line+1)
+			}
+			if !isPrefix {
+				break
+			}
+		}
+		if len(line) > 0 {
+			return line, nil
+		}
+		r.line++
+	}
+}
+
+// Item returns the current document
+func (r *Reader[T]) Item() T {
+	return r.item
+}
+
+// Raw returns the JSON of the current document
+func (r *Reader[T]) Raw() json.RawMessage {
+	return r.raw
+}
+
+// Err returns the error that stopped the reader, if any
+func (r *Reader[T]) Err() error {
+	return r.err
+}
+
+// LoadCache preloads the cache of client with every document of a dump of
+// the given entity type, as the responses to lookups made with opts. Dumps
+// hold most includes, such as aliases, tags, genres and relationships, so
+// lookups requesting any of them can be served from the dump once opts
+// lists them. It returns the number of documents loaded.
+func LoadCache(client *musicbrainz.Client, dump io.Reader, entity string, opts ...musicbrainz.RequestOption) (int, error) {
+	r := NewReader[struct {
+		ID musicbrainz.MBID `json:"id"`
+	}](dump)
+	n := 0
+	for r.Next() {
+		id := r.Item().ID
+		if id == "" {
+			return n, errors.New("mbdump: document without an ID")
+		}
+		if err := client.Preload(entity, id, r.Raw(), opts...); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, r.Err()
+}
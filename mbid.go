@@ -0,0 +1,64 @@
+package musicbrainz
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MBID is a MusicBrainz identifier, the UUID of an entity such as
+// "5b11f4ce-a62d-471e-81fc-a69a8278c7da". Lookups and browses check that the
+// MBIDs they are given are well formed before making a request.
+type MBID string
+
+// ParseMBID parses s as an MBID, ignoring case and surrounding whitespace. It
+// returns an error wrapping ErrInvalidMBID if s is not a UUID.
+func ParseMBID(s string) (MBID, error) {
+	id := MBID(strings.ToLower(strings.TrimSpace(s)))
+	if !id.Valid() {
+		return "", fmt.Errorf("%w %q", ErrInvalidMBID, s)
+	}
+	return id, nil
+}
+
+// MustParseMBID is like ParseMBID but panics if s is not a valid MBID. It
+// simplifies the initialization of variables holding well-known MBIDs.
+func MustParseMBID(s string) MBID {
+	id, err := ParseMBID(s)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// Valid reports whether id is a well-formed UUID
+func (id MBID) Valid() bool {
+	if len(id) != 36 {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		c := id[i]
+		switch i {
+		case 8, 13, 18, 23:
+			if c != '-' {
+				return false
+			}
+		default:
+			if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (id MBID) String() string {
+	return string(id)
+}
+
+// validate returns an error wrapping ErrInvalidMBID if id is not well formed
+func (id MBID) validate() error {
+	if !id.Valid() {
+		return fmt.Errorf("%w %q", ErrInvalidMBID, string(id))
+	}
+	return nil
+}
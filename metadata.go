@@ -0,0 +1,241 @@
+package musicbrainz
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// TrackMetadata holds the tag values of a recording on a release, named
+// after the fields found in ID3 frames and Vorbis comments. Its MBID fields
+// follow the conventions of MusicBrainz Picard, in which the track ID is the
+// recording MBID and the release track ID the MBID of the track.
+type TrackMetadata struct {
+	Title           string
+	Artist          string
+	ArtistSort      string
+	Artists         []string
+	Album           string
+	AlbumArtist     string
+	AlbumArtistSort string
+	TrackNumber     int
+	TrackTotal      int
+	DiscNumber      int
+	DiscTotal       int
+	DiscSubtitle    string
+	// Date is the release date and OriginalDate the first release date of
+	// the release group, formatted as "YYYY", "YYYY-MM" or "YYYY-MM-DD"
+	Date           string
+	OriginalDate   string
+	Genre          string
+	Genres         []string
+	ISRC           string
+	ISRCs          []string
+	Label          string
+	CatalogNumber  string
+	Barcode        string
+	Media          string
+	ReleaseCountry string
+	ReleaseStatus  string
+	ReleaseType    string
+	Script         string
+	Compilation    bool
+
+	MusicBrainzTrackID        MBID
+	MusicBrainzReleaseTrackID MBID
+	MusicBrainzAlbumID        MBID
+	MusicBrainzReleaseGroupID MBID
+	MusicBrainzArtistIDs      []MBID
+	MusicBrainzAlbumArtistIDs []MBID
+}
+
+// BuildTrackMetadata gathers the tag values of a recording as it appears on
+// a release. When releaseID is empty, the release is chosen with
+// GetCanonicalRelease. Genres are ranked as by GetTopGenres, but from the
+// release group of the release, Genre being the top one from the official
+// genre list. This takes up to four requests plus one per artist of the
+// recording.
+func (c *Client) BuildTrackMetadata(ctx context.Context, recordingID, releaseID MBID) (*TrackMetadata, error) {
+	if releaseID == "" {
+		canonical, err := c.GetCanonicalRelease(ctx, recordingID)
+		if err != nil {
+			return nil, err
+		}
+		releaseID = canonical.ID
+	}
+	release, err := c.GetReleaseByID(ctx, releaseID, WithIncludes("recordings", "artist-credits", "release-groups", "labels", "isrcs"))
+	if err != nil {
+		return nil, err
+	}
+
+	medium, track := findRecording(release, recordingID)
+	if track == nil {
+		return nil, fmt.Errorf("%w: recording %s on release %s", ErrNotFound, recordingID, releaseID)
+	}
+	credit := track.ArtistCredit
+	if len(credit) == 0 {
+		credit = track.Recording.ArtistCredit
+	}
+
+	m := &TrackMetadata{
+		Title:           track.Title,
+		Artist:          credit.String(),
+		ArtistSort:      sortCredit(credit),
+		Artists:         creditedNames(credit),
+		Album:           release.Title,
+		AlbumArtist:     release.ArtistCredit.String(),
+		AlbumArtistSort: sortCredit(release.ArtistCredit),
+		TrackNumber:     track.Position,
+		TrackTotal:      medium.TrackCount,
+		DiscNumber:      medium.Position,
+		DiscTotal:       len(release.Media),
+		DiscSubtitle:    medium.Title,
+		Date:            release.Date.String(),
+		OriginalDate:    release.ReleaseGroup.FirstReleaseDate.String(),
+		ISRCs:           track.Recording.ISRCs,
+		Barcode:         release.Barcode,
+		Media:           medium.Format,
+		ReleaseCountry:  release.Country,
+		ReleaseStatus:   strings.ToLower(string(release.Status)),
+		ReleaseType:     strings.ToLower(string(release.ReleaseGroup.PrimaryType)),
+		Script:          release.TextRepresentation.Script,
+		Compilation:     IsCompilation(release),
+
+		MusicBrainzTrackID:        track.Recording.ID,
+		MusicBrainzReleaseTrackID: track.ID,
+		MusicBrainzAlbumID:        release.ID,
+		MusicBrainzReleaseGroupID: release.ReleaseGroup.ID,
+		MusicBrainzArtistIDs:      credit.IDs(),
+		MusicBrainzAlbumArtistIDs: release.ArtistCredit.IDs(),
+	}
+	if m.TrackTotal == 0 {
+		m.TrackTotal = len(medium.Tracks)
+	}
+	if len(m.ISRCs) > 0 {
+		m.ISRC = m.ISRCs[0]
+	}
+	if len(release.LabelInfo) > 0 {
+		info := release.LabelInfo[0]
+		m.CatalogNumber = info.CatalogNumber
+		if info.Label != nil {
+			m.Label = info.Label.Name
+		}
+	}
+
+	recording, err := c.GetRecordingByID(ctx, track.Recording.ID, WithIncludes("genres", "tags", "artist-credits"))
+	if err != nil {
+		return nil, err
+	}
+	genres, err := c.topGenres(ctx, recording, release.ReleaseGroup.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, genre := range genres {
+		m.Genres = append(m.Genres, genre.Name)
+		if m.Genre == "" && genre.Genre {
+			m.Genre = genre.Name
+		}
+	}
+	return m, nil
+}
+
+// VorbisComments returns the metadata as Vorbis comments, using the field
+// names MusicBrainz Picard writes. Empty fields are left out.
+func (m *TrackMetadata) VorbisComments() map[string][]string {
+	comments := make(map[string][]string)
+	set := func(name string, values ...string) {
+		for _, value := range values {
+			if value != "" {
+				comments[name] = append(comments[name], value)
+			}
+		}
+	}
+	number := func(n int) string {
+		if n == 0 {
+			return ""
+		}
+		return strconv.Itoa(n)
+	}
+
+	set("TITLE", m.Title)
+	set("ARTIST", m.Artist)
+	set("ARTISTSORT", m.ArtistSort)
+	set("ARTISTS", m.Artists...)
+	set("ALBUM", m.Album)
+	set("ALBUMARTIST", m.AlbumArtist)
+	set("ALBUMARTISTSORT", m.AlbumArtistSort)
+	set("TRACKNUMBER", number(m.TrackNumber))
+	set("TRACKTOTAL", number(m.TrackTotal))
+	set("DISCNUMBER", number(m.DiscNumber))
+	set("DISCTOTAL", number(m.DiscTotal))
+	set("DISCSUBTITLE", m.DiscSubtitle)
+	set("DATE", m.Date)
+	set("ORIGINALDATE", m.OriginalDate)
+	set("GENRE", m.Genre)
+	set("ISRC", m.ISRCs...)
+	set("LABEL", m.Label)
+	set("CATALOGNUMBER", m.CatalogNumber)
+	set("BARCODE", m.Barcode)
+	set("MEDIA", m.Media)
+	set("RELEASECOUNTRY", m.ReleaseCountry)
+	set("RELEASESTATUS", m.ReleaseStatus)
+	set("RELEASETYPE", m.ReleaseType)
+	set("SCRIPT", m.Script)
+	if m.Compilation {
+		set("COMPILATION", "1")
+	}
+	set("MUSICBRAINZ_TRACKID", string(m.MusicBrainzTrackID))
+	set("MUSICBRAINZ_RELEASETRACKID", string(m.MusicBrainzReleaseTrackID))
+	set("MUSICBRAINZ_ALBUMID", string(m.MusicBrainzAlbumID))
+	set("MUSICBRAINZ_RELEASEGROUPID", string(m.MusicBrainzReleaseGroupID))
+	for _, id := range m.MusicBrainzArtistIDs {
+		set("MUSICBRAINZ_ARTISTID", string(id))
+	}
+	for _, id := range m.MusicBrainzAlbumArtistIDs {
+		set("MUSICBRAINZ_ALBUMARTISTID", string(id))
+	}
+	return comments
+}
+
+// findRecording returns the first track of release, and its medium, that is
+// a recording with the given ID
+func findRecording(release *Release, recordingID MBID) (*Medium, *Track) {
+	for i := range release.Media {
+		medium := &release.Media[i]
+		for j := range medium.Tracks {
+			if medium.Tracks[j].Recording.ID == recordingID {
+				return medium, &medium.Tracks[j]
+			}
+		}
+	}
+	return nil, nil
+}
+
+// sortCredit renders an artist credit with the sort names of the artists,
+// such as "Beatles, The"
+func sortCredit(credits ArtistCredits) string {
+	var b strings.Builder
+	for _, credit := range credits {
+		name := credit.Artist.SortName
+		if name == "" {
+			name = credit.Name
+		}
+		b.WriteString(name)
+		b.WriteString(credit.JoinPhrase)
+	}
+	return b.String()
+}
+
+// creditedNames returns the names of the credited artists
+func creditedNames(credits ArtistCredits) []string {
+	names := make([]string, 0, len(credits))
+	for _, credit := range credits {
+		name := credit.Name
+		if name == "" {
+			name = credit.Artist.Name
+		}
+		names = append(names, name)
+	}
+	return names
+}
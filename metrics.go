@@ -0,0 +1,74 @@
+package musicbrainz
+
+import (
+	"net/url"
+	"time"
+)
+
+// Metrics records measurements of the requests of a Client, for monitoring
+// high-volume clients. Endpoints are named as in Call. Metrics of a Client
+// used concurrently must be safe for concurrent use; the prommusicbrainz
+// module implements Metrics with Prometheus collectors.
+type Metrics interface {
+	// ObserveRequest records an HTTP attempt to endpoint and how long it
+	// took. statusCode is 0 if the attempt failed without a response.
+	ObserveRequest(endpoint string, statusCode int, elapsed time.Duration)
+	// ObserveRetry records that a failed attempt to endpoint is retried
+	ObserveRetry(endpoint string)
+	// ObserveRateLimitWait records how long a request to endpoint waited
+	// for the rate limiter before an attempt
+	ObserveRateLimitWait(endpoint string, wait time.Duration)
+	// ObserveCache records whether a request to endpoint was answered from
+	// the cache. Responses revalidated with the server count as hits.
+	ObserveCache(endpoint string, hit bool)
+}
+
+// WithMetrics records the requests of the client, their retries, rate limit
+// waits and cache lookups with metrics
+func WithMetrics(metrics Metrics) Option {
+	return func(c *Client) error {
+		c.metrics = append(c.metrics, metrics)
+		return nil
+	}
+}
+
+// endpoint returns the endpoint of a request to u, for metrics
+func (c *Client) endpoint(u *url.URL) string {
+	if len(c.metrics) == 0 {
+		return ""
+	}
+	return c.describeCall("", u).Endpoint
+}
+
+func (c *Client) observeRequest(endpoint string, statusCode int, elapsed time.Duration) {
+	for _, metrics := range c.metrics {
+		metrics.ObserveRequest(endpoint, statusCode, elapsed)
+	}
+}
+
+func (c *Client) observeRetry(endpoint string) {
+	for _, metrics := range c.metrics {
+		metrics.ObserveRetry(endpoint)
+	}
+}
+
+func (c *Client) observeRateLimitWait(endpoint string, wait time.Duration) {
+	for _, metrics := range c.metrics {
+		metrics.ObserveRateLimitWait(endpoint, wait)
+	}
+}
+
+// observeCache records a cache lookup for rawURL
+func (c *Client) observeCache(rawURL string, hit bool) {
+	if len(c.metrics) == 0 {
+		return
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return
+	}
+	endpoint := c.endpoint(u)
+	for _, metrics := range c.metrics {
+		metrics.ObserveCache(endpoint, hit)
+	}
+}
@@ -1,74 +1,138 @@
 package musicbrainz
 
 import (
-	"encoding/json"
+	"context"
 	"errors"
-	"fmt"
-	"io"
-	"net/http"
 	"net/url"
-	"strconv"
+	"strings"
+	"time"
 )
 
 // MusicBrainzAPIEndpoint represents the base URL of the MusicBrainz API
 const MusicBrainzAPIEndpoint = "https://musicbrainz.org/ws/2/"
 
+// TestMusicBrainzAPIEndpoint represents the base URL of the MusicBrainz test
+// server, whose data is regularly reset and safe to edit
+const TestMusicBrainzAPIEndpoint = "https://test.musicbrainz.org/ws/2/"
+
 // Artist represents an artist in the MusicBrainz database
 type Artist struct {
-	ID        string     `json:"id"`
-	Name      string     `json:"name"`
-	SortName  string     `json:"sort-name"`
-	Type      string     `json:"type"`
-	Country   string     `json:"country"`
-	Area      string     `json:"area"`
-	BeginDate string     `json:"begin_date"`
-	EndDate   string     `json:"end_date"`
-	Disambig  string     `json:"disambiguation"`
-	Aliases   []Alias    `json:"aliases"`
-	Relations []Relation `json:"relations"`
-	Tags      []Tag      `json:"tags"`
-}
-
-// Alias represents an artist's alias in the MusicBrainz database
-type Alias struct {
-	Name string `json:"name"`
-	Type string `json:"type"`
+	ID          MBID       `json:"id"`
+	RequestedID MBID       `json:"-"`
+	Name        string     `json:"name"`
+	SortName    string     `json:"sort-name"`
+	Type        ArtistType `json:"type"`
+	Country     string     `json:"country"`
+	Area        *Area      `json:"area"`
+	BeginArea   *Area      `json:"begin-area"`
+	EndArea     *Area      `json:"end-area"`
+	LifeSpan    LifeSpan   `json:"life-span"`
+	Disambig    string     `json:"disambiguation"`
+	Aliases     Aliases    `json:"aliases"`
+	Relations   []Relation `json:"relations"`
+	Tags        []Tag      `json:"tags"`
+	Genres      []Genre    `json:"genres"`
+	UserTags    []Tag      `json:"user-tags"`
+	UserGenres  []Genre    `json:"user-genres"`
+	Rating      *Rating    `json:"rating"`
+	UserRating  *Rating    `json:"user-rating"`
+	Score       int        `json:"score"`
 }
 
-// Relation represents a relation between artists in the MusicBrainz database
-type Relation struct {
-	Type   string `json:"type"`
-	URL    string `json:"url"`
-	Artist Artist `json:"artist"`
+// Tag represents a folksonomy tag applied to an entity. Count is the number
+// of users who applied the tag.
+type Tag struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
 }
 
-// Tag represents a tag associated with an artist in the MusicBrainz database
-type Tag struct {
-	Name string `json:"name"`
+// Rating represents the community rating of an entity, requested with the
+// "ratings" include. Value ranges from 0 to 5 and is zero when nobody voted.
+// The rating of the authenticated user, requested with the "user-ratings"
+// include, has no VotesCount.
+type Rating struct {
+	Value      float64 `json:"value"`
+	VotesCount int     `json:"votes-count"`
 }
 
 // Release represents a release in the MusicBrainz database
 type Release struct {
-	ID                string             `json:"id"`
-	Title             string             `json:"title"`
-	Status            string             `json:"status"`
-	TextRepresetation TextRepresentation `json:"text-representation"`
-	ArtistCredit      []ArtistCredit     `json:"artist-credit"`
-	ReleaseGroup      ReleaseGroup       `json:"release-group"`
-	Relations         []Relation         `json:"relations"`
-	Tags              []Tag              `json:"tags"`
-	CoverArtURL       []CoverArtURL      `json:"cover-art-archive"`
-}
-type CoverArtURL struct {
-	Artwork bool      `json:"artwork"`
-	Front   bool      `json:"front"`
-	Back    bool      `json:"back"`
-	Count   int       `json:"count"`
-	Images  []GBImage `json:"images"`
+	ID                 MBID               `json:"id"`
+	RequestedID        MBID               `json:"-"`
+	Title              string             `json:"title"`
+	Status             ReleaseStatus      `json:"status"`
+	Disambig           string             `json:"disambiguation"`
+	Date               PartialDate        `json:"date"`
+	Country            string             `json:"country"`
+	Barcode            string             `json:"barcode"`
+	ASIN               string             `json:"asin"`
+	Packaging          string             `json:"packaging"`
+	Quality            string             `json:"quality"`
+	TextRepresentation TextRepresentation `json:"text-representation"`
+	ArtistCredit       ArtistCredits      `json:"artist-credit"`
+	ReleaseGroup       ReleaseGroup       `json:"release-group"`
+	ReleaseEvents      []ReleaseEvent     `json:"release-events"`
+	LabelInfo          []LabelInfo        `json:"label-info"`
+	TrackCount         int                `json:"track-count"`
+	Media              []Medium           `json:"media"`
+	CoverArtArchive    CoverArtArchive    `json:"cover-art-archive"`
+	Relations          []Relation         `json:"relations"`
+	Tags               []Tag              `json:"tags"`
+	Genres             []Genre            `json:"genres"`
+	UserTags           []Tag              `json:"user-tags"`
+	UserGenres         []Genre            `json:"user-genres"`
+	Score              int                `json:"score"`
 }
-type GBImage struct {
-	ImageURL string   `json:"image"`
-	Types    []string `json:"types"`
+
+// ReleaseEvent represents the date a release was issued in an area
+type ReleaseEvent struct {
+	Date PartialDate `json:"date"`
+	Area *Area       `json:"area"`
+}
+
+// LabelInfo represents a label a release was issued on, along with its
+// catalog number on that label
+type LabelInfo struct {
+	CatalogNumber string `json:"catalog-number"`
+	Label         *Label `json:"label"`
+}
+
+// CoverArtArchive summarizes the artwork of a release available from the
+// Cover Art Archive. Use CoverArtClient to retrieve the images.
+type CoverArtArchive struct {
+	Artwork  bool `json:"artwork"`
+	Count    int  `json:"count"`
+	Front    bool `json:"front"`
+	Back     bool `json:"back"`
+	Darkened bool `json:"darkened"`
+}
+
+// Medium represents a medium of a release, such as a CD or a vinyl side.
+// Tracks are only populated when the release is retrieved with the
+// "recordings" include, and Discs with the "discids" include.
+type Medium struct {
+	Position    int     `json:"position"`
+	Title       string  `json:"title"`
+	Format      string  `json:"format"`
+	DiscCount   int     `json:"disc-count"`
+	TrackCount  int     `json:"track-count"`
+	TrackOffset int     `json:"track-offset"`
+	Discs       []Disc  `json:"discs"`
+	Pregap      *Track  `json:"pregap"`
+	Tracks      []Track `json:"tracks"`
+	DataTracks  []Track `json:"data-tracks"`
+}
+
+// Track represents a track on a medium. Its artist credit may differ from
+// the credit of the release, for example on compilations.
+type Track struct {
+	ID           MBID          `json:"id"`
+	Position     int           `json:"position"`
+	Number       string        `json:"number"`
+	Title        string        `json:"title"`
+	Length       Duration      `json:"length"`
+	ArtistCredit ArtistCredits `json:"artist-credit"`
+	Recording    Recording     `json:"recording"`
 }
 
 // TextRepresentation represents the text representation of a release in the MusicBrainz database
@@ -77,272 +141,361 @@ type TextRepresentation struct {
 	Script   string `json:"script"`
 }
 
-// ArtistCredit represents the artist credit of a release in the MusicBrainz database
+// ArtistCredit represents one of the artists credited on a release,
+// recording or track, as credited, and the phrase joining it to the next
+// credited artist, such as " feat. "
 type ArtistCredit struct {
-	Name string `json:"name"`
+	Name       string `json:"name"`
+	JoinPhrase string `json:"joinphrase"`
+	Artist     Artist `json:"artist"`
+}
+
+// ArtistCredits represents the full artist credit of a release, recording or
+// track
+type ArtistCredits []ArtistCredit
+
+// String renders the artist credit as displayed by MusicBrainz, such as
+// "Daft Punk feat. Pharrell Williams"
+func (credits ArtistCredits) String() string {
+	var b strings.Builder
+	for _, credit := range credits {
+		name := credit.Name
+		if name == "" {
+			name = credit.Artist.Name
+		}
+		b.WriteString(name)
+		b.WriteString(credit.JoinPhrase)
+	}
+	return b.String()
+}
+
+// IDs returns the MBIDs of the credited artists, without duplicates
+func (credits ArtistCredits) IDs() []MBID {
+	var ids []MBID
+	for _, credit := range credits {
+		id := credit.Artist.ID
+		if id != "" && !containsMBID(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func containsMBID(ids []MBID, id MBID) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
 }
 
 // ArtistName represents the name of an artist in the MusicBrainz database
+//
+// Deprecated: artist credits are represented by ArtistCredits.
 type ArtistName struct {
 	Name string `json:"name"`
 }
 
-// ReleaseGroup represents the release group of a release in the MusicBrainz database
-type ReleaseGroup struct {
-	ID   string `json:"id"`
-	Type string `json:"type"`
-}
-
 // Recording represents a recording in the MusicBrainz database
 type Recording struct {
-	ID           string       `json:"id"`
-	Title        string       `json:"title"`
-	Length       int          `json:"length"`
-	ReleaseDate  string       `json:"first-release-date"`
-	Relations    []Relation   `json:"relations"`
-	Tags         []Tag        `json:"tags"`
-	ArtistCredit []ArtistName `json:"artist-credit"`
-	Releases     []Release    `json:"releases"`
+	ID           MBID          `json:"id"`
+	RequestedID  MBID          `json:"-"`
+	Title        string        `json:"title"`
+	Length       Duration      `json:"length"`
+	Video        bool          `json:"video"`
+	ISRCs        []string      `json:"isrcs"`
+	ReleaseDate  PartialDate   `json:"first-release-date"`
+	Relations    []Relation    `json:"relations"`
+	Tags         []Tag         `json:"tags"`
+	Genres       []Genre       `json:"genres"`
+	UserTags     []Tag         `json:"user-tags"`
+	UserGenres   []Genre       `json:"user-genres"`
+	Rating       *Rating       `json:"rating"`
+	UserRating   *Rating       `json:"user-rating"`
+	ArtistCredit ArtistCredits `json:"artist-credit"`
+	Releases     []Release     `json:"releases"`
+	Score        int           `json:"score"`
 }
 
-// SearchArtists searches for artists by their name
-func SearchArtists(name string, limit int) ([]Artist, error) {
-	params := url.Values{}
-	params.Set("query", name)
-	params.Set("limit", strconv.Itoa(limit))
-	params.Set("fmt", "json")
-
-	url := fmt.Sprintf("%sartist/?%s", MusicBrainzAPIEndpoint, params.Encode())
-	response, err := http.Get(url)
-	if err != nil {
-		return nil, err
-	}
-	defer response.Body.Close()
+// ArtistSearchResult is a page of artists returned by a search
+type ArtistSearchResult struct {
+	Count   int       `json:"count"`
+	Offset  int       `json:"offset"`
+	Created time.Time `json:"created"`
+	Artists []Artist  `json:"artists"`
+}
 
-	body, err := io.ReadAll(response.Body)
-	if err != nil {
+// SearchArtists searches for artists by their name. Use WithOffset to page through
+// the results.
+func (c *Client) SearchArtists(ctx context.Context, name string, limit int, opts ...RequestOption) (*ArtistSearchResult, error) {
+	var result ArtistSearchResult
+	if err := c.search(ctx, "artist", name, limit, opts, &result); err != nil {
 		return nil, err
 	}
 
-	var result struct {
-		Artists []Artist `json:"artists"`
-	}
-	err = json.Unmarshal(body, &result)
-	if err != nil {
-		return nil, err
-	}
+	return &result, nil
+}
 
-	return result.Artists, nil
+// SearchAllArtists returns a Pager over the artists matching a search, stopping
+// after maxResults results. A maxResults of zero pages through every result.
+func (c *Client) SearchAllArtists(ctx context.Context, name string, maxResults int, opts ...RequestOption) *Pager[Artist] {
+	return searchPager(ctx, maxResults, func(ctx context.Context, limit, offset int) ([]Artist, int, error) {
+		result, err := c.SearchArtists(ctx, name, limit, withOffset(opts, offset)...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.Artists, result.Count, nil
+	})
 }
 
 // GetArtistByID retrieves an artist by their ID
-func GetArtistByID(id string) (*Artist, error) {
-	url := fmt.Sprintf("%sartist/%s?fmt=json", MusicBrainzAPIEndpoint, id)
-	response, err := http.Get(url)
-	if err != nil {
-		return nil, err
-	}
-	defer response.Body.Close()
-
-	body, err := io.ReadAll(response.Body)
-	if err != nil {
-		return nil, err
-	}
-
+func (c *Client) GetArtistByID(ctx context.Context, id MBID, opts ...RequestOption) (*Artist, error) {
 	var artist Artist
-	err = json.Unmarshal(body, &artist)
-	if err != nil {
+	if err := c.lookup(ctx, "artist", id, opts, &artist); err != nil {
 		return nil, err
 	}
+	artist.RequestedID = id
 
 	return &artist, nil
 }
 
-// SearchReleases searches for releases by their title
-func SearchReleases(title string, limit int) ([]Release, error) {
-	params := url.Values{}
-	params.Set("query", title)
-	params.Set("limit", strconv.Itoa(limit))
-	params.Set("fmt", "json")
+// ReleaseSearchResult is a page of releases returned by a search
+type ReleaseSearchResult struct {
+	Count    int       `json:"count"`
+	Offset   int       `json:"offset"`
+	Created  time.Time `json:"created"`
+	Releases []Release `json:"releases"`
+}
 
-	url := fmt.Sprintf("%srelease/?%s", MusicBrainzAPIEndpoint, params.Encode())
-	response, err := http.Get(url)
-	if err != nil {
+// SearchReleases searches for releases by their title. Use WithOffset to page through
+// the results.
+func (c *Client) SearchReleases(ctx context.Context, title string, limit int, opts ...RequestOption) (*ReleaseSearchResult, error) {
+	var result ReleaseSearchResult
+	if err := c.search(ctx, "release", title, limit, opts, &result); err != nil {
 		return nil, err
 	}
-	defer response.Body.Close()
 
-	body, err := io.ReadAll(response.Body)
-	if err != nil {
-		return nil, err
-	}
+	return &result, nil
+}
 
-	var result struct {
-		Releases []Release `json:"releases"`
-	}
-	err = json.Unmarshal(body, &result)
-	if err != nil {
+// SearchAllReleases returns a Pager over the releases matching a search, stopping
+// after maxResults results. A maxResults of zero pages through every result.
+func (c *Client) SearchAllReleases(ctx context.Context, title string, maxResults int, opts ...RequestOption) *Pager[Release] {
+	return searchPager(ctx, maxResults, func(ctx context.Context, limit, offset int) ([]Release, int, error) {
+		result, err := c.SearchReleases(ctx, title, limit, withOffset(opts, offset)...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.Releases, result.Count, nil
+	})
+}
+
+// SearchReleasesByBarcode searches for releases by their barcode, such as the
+// UPC or EAN printed on a CD
+func (c *Client) SearchReleasesByBarcode(ctx context.Context, barcode string) ([]Release, error) {
+	var result ReleaseSearchResult
+	if err := c.search(ctx, "release", ReleaseQuery{Barcode: barcode}.String(), 25, nil, &result); err != nil {
 		return nil, err
 	}
 
 	return result.Releases, nil
 }
 
-// GetReleaseByID retrieves a release by its ID
-func GetReleaseByID(id string) (*Release, error) {
-	url := fmt.Sprintf("%srelease/%s?fmt=json", MusicBrainzAPIEndpoint, id)
-	response, err := http.Get(url)
-	if err != nil {
+// SearchReleasesByCatalogNumber searches for releases by their label catalog
+// number
+func (c *Client) SearchReleasesByCatalogNumber(ctx context.Context, catalogNumber string) ([]Release, error) {
+	var result ReleaseSearchResult
+	if err := c.search(ctx, "release", ReleaseQuery{CatalogNumber: catalogNumber}.String(), 25, nil, &result); err != nil {
 		return nil, err
 	}
-	defer response.Body.Close()
 
-	body, err := io.ReadAll(response.Body)
-	if err != nil {
-		return nil, err
-	}
+	return result.Releases, nil
+}
 
+// GetReleaseByID retrieves a release by its ID
+func (c *Client) GetReleaseByID(ctx context.Context, id MBID, opts ...RequestOption) (*Release, error) {
 	var release Release
-	err = json.Unmarshal(body, &release)
-	if err != nil {
+	if err := c.lookup(ctx, "release", id, opts, &release); err != nil {
 		return nil, err
 	}
+	release.RequestedID = id
 
 	return &release, nil
 }
 
-// SearchRecordings searches for recordings by their title
-func SearchRecordings(title string, limit int) ([]Recording, error) {
-	params := url.Values{}
-	params.Set("query", title)
-	params.Set("limit", strconv.Itoa(limit))
-	params.Set("fmt", "json")
-
-	url := fmt.Sprintf("%srecording/?%s", MusicBrainzAPIEndpoint, params.Encode())
-	response, err := http.Get(url)
-	if err != nil {
-		return nil, err
-	}
-	defer response.Body.Close()
+// RecordingSearchResult is a page of recordings returned by a search
+type RecordingSearchResult struct {
+	Count      int         `json:"count"`
+	Offset     int         `json:"offset"`
+	Created    time.Time   `json:"created"`
+	Recordings []Recording `json:"recordings"`
+}
 
-	body, err := io.ReadAll(response.Body)
-	if err != nil {
+// SearchRecordings searches for recordings by their title. Use WithOffset to page through
+// the results.
+func (c *Client) SearchRecordings(ctx context.Context, title string, limit int, opts ...RequestOption) (*RecordingSearchResult, error) {
+	var result RecordingSearchResult
+	if err := c.search(ctx, "recording", title, limit, opts, &result); err != nil {
 		return nil, err
 	}
 
-	var result struct {
-		Recordings []Recording `json:"recordings"`
-	}
-	err = json.Unmarshal(body, &result)
-	if err != nil {
-		return nil, err
-	}
+	return &result, nil
+}
 
-	return result.Recordings, nil
+// SearchAllRecordings returns a Pager over the recordings matching a search, stopping
+// after maxResults results. A maxResults of zero pages through every result.
+func (c *Client) SearchAllRecordings(ctx context.Context, title string, maxResults int, opts ...RequestOption) *Pager[Recording] {
+	return searchPager(ctx, maxResults, func(ctx context.Context, limit, offset int) ([]Recording, int, error) {
+		result, err := c.SearchRecordings(ctx, title, limit, withOffset(opts, offset)...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.Recordings, result.Count, nil
+	})
 }
 
 // GetRecordingByID retrieves a recording by its ID
-func GetRecordingByID(id string) (*Recording, error) {
-	url := fmt.Sprintf("%srecording/%s?fmt=json", MusicBrainzAPIEndpoint, id)
-	response, err := http.Get(url)
-	if err != nil {
+func (c *Client) GetRecordingByID(ctx context.Context, id MBID, opts ...RequestOption) (*Recording, error) {
+	var recording Recording
+	if err := c.lookup(ctx, "recording", id, opts, &recording); err != nil {
 		return nil, err
 	}
-	defer response.Body.Close()
+	recording.RequestedID = id
 
-	body, err := io.ReadAll(response.Body)
-	if err != nil {
+	return &recording, nil
+}
+
+// GetRecordingsByISRC retrieves the recordings bound to an ISRC, along with
+// their artist credits
+func (c *Client) GetRecordingsByISRC(ctx context.Context, isrc string, opts ...RequestOption) ([]Recording, error) {
+	params := url.Values{}
+	o := collectOptions(append([]RequestOption{WithIncludes("artist-credits")}, opts...))
+	if err := o.setIncludes(params, "recording"); err != nil {
 		return nil, err
 	}
 
-	var recording Recording
-	err = json.Unmarshal(body, &recording)
-	if err != nil {
+	var result struct {
+		Recordings []Recording `json:"recordings"`
+	}
+	if err := c.get(ctx, "isrc/"+url.PathEscape(isrc), params, o.target(&result)); err != nil {
 		return nil, err
 	}
 
-	return &recording, nil
+	return result.Recordings, nil
 }
 
-// searchRecordings searches for recordings by song title and artist name
-func SearchRecordingsByTitleAndArtist(title, artist string) ([]Recording, error) {
-	query := url.QueryEscape(fmt.Sprintf("recording:%s artist:%s", title, artist))
-	url := fmt.Sprintf("%srecording/?query=%s&limit=20&fmt=json", MusicBrainzAPIEndpoint, query)
+// SearchRecordingsByTitleAndArtist searches for recordings by song title and artist name
+func (c *Client) SearchRecordingsByTitleAndArtist(ctx context.Context, title, artist string) ([]Recording, error) {
+	query := RecordingQuery{Recording: title, Artist: artist}.String()
 
-	response, err := http.Get(url)
-	if err != nil {
+	var result RecordingSearchResult
+	if err := c.search(ctx, "recording", query, 20, nil, &result); err != nil {
 		return nil, err
 	}
-	defer response.Body.Close()
 
-	body, err := io.ReadAll(response.Body)
-	if err != nil {
-		return nil, err
-	}
+	return result.Recordings, nil
+}
 
-	var result struct {
-		Recordings []Recording `json:"recordings"`
+// SearchRecordingsByTitleArtistDuration searches for recordings by song
+// title and artist name whose length is within tolerance of dur, which is far
+// more accurate than matching on title and artist alone
+func (c *Client) SearchRecordingsByTitleArtistDuration(ctx context.Context, title, artist string, dur, tolerance time.Duration) ([]Recording, error) {
+	from := dur - tolerance
+	if from < 0 {
+		from = 0
 	}
-	err = json.Unmarshal(body, &result)
-	if err != nil {
+	query := And(RecordingQuery{Recording: title, Artist: artist}, Range("dur", from, dur+tolerance)).String()
+
+	var result RecordingSearchResult
+	if err := c.search(ctx, "recording", query, 20, nil, &result); err != nil {
 		return nil, err
 	}
 
 	return result.Recordings, nil
 }
 
-func GetTagsByTitleAndArtistAndAlbum(title, artist string, album string) ([]Tag, string, error) {
-	query := url.QueryEscape(fmt.Sprintf("recording:%s artist:%s release:%s", title, artist, album))
-	url := fmt.Sprintf("%srecording/?query=%s&limit=1&fmt=json", MusicBrainzAPIEndpoint, query)
+// GetTagsByTitleAndArtistAndAlbum retrieves the tags and first release date of
+// the recording best matching the given title, artist and album
+func (c *Client) GetTagsByTitleAndArtistAndAlbum(ctx context.Context, title, artist string, album string) ([]Tag, string, error) {
+	query := RecordingQuery{Recording: title, Artist: artist, Release: album}.String()
 
-	response, err := http.Get(url)
-	if err != nil {
+	var result RecordingSearchResult
+	if err := c.search(ctx, "recording", query, 1, nil, &result); err != nil {
 		return nil, "", err
 	}
-	defer response.Body.Close()
-
-	body, err := io.ReadAll(response.Body)
-	if err != nil {
-		return nil, "", err
+	if len(result.Recordings) != 1 {
+		return nil, "", errors.New("MusicBrainz didn't find the song")
 	}
 
-	var result struct {
-		Recordings []Recording `json:"recordings"`
-	}
-	err = json.Unmarshal(body, &result)
+	recording, err := c.GetRecordingByIDWithTags(ctx, result.Recordings[0].ID)
 	if err != nil {
 		return nil, "", err
 	}
-	if len(result.Recordings) == 1 {
-		recording, err := GetRecordingByIDWithTags(result.Recordings[0].ID)
-		if err != nil {
-			return nil, "", err
-		}
-		return recording.Tags, recording.ReleaseDate, nil
-	} else {
-		err = errors.New("MusicBrainz didn't find the song")
-	}
+	return recording.Tags, recording.ReleaseDate.String(), nil
+}
 
-	return nil, "", err
+// GetRecordingByIDWithTags retrieves a recording and its tags by its ID
+func (c *Client) GetRecordingByIDWithTags(ctx context.Context, id MBID) (*Recording, error) {
+	return c.GetRecordingByID(ctx, id, WithIncludes("tags"))
 }
-func GetRecordingByIDWithTags(id string) (*Recording, error) {
-	url := fmt.Sprintf("%srecording/%s?fmt=json", MusicBrainzAPIEndpoint, id)
-	response, err := http.Get(url)
+
+// SearchArtists searches for artists by their name using DefaultClient
+func SearchArtists(name string, limit int) ([]Artist, error) {
+	result, err := DefaultClient.SearchArtists(context.Background(), name, limit)
 	if err != nil {
 		return nil, err
 	}
-	defer response.Body.Close()
+	return result.Artists, nil
+}
+
+// GetArtistByID retrieves an artist by their ID using DefaultClient
+func GetArtistByID(id string, opts ...RequestOption) (*Artist, error) {
+	return DefaultClient.GetArtistByID(context.Background(), MBID(id), opts...)
+}
 
-	body, err := io.ReadAll(response.Body)
+// SearchReleases searches for releases by their title using DefaultClient
+func SearchReleases(title string, limit int) ([]Release, error) {
+	result, err := DefaultClient.SearchReleases(context.Background(), title, limit)
 	if err != nil {
 		return nil, err
 	}
+	return result.Releases, nil
+}
 
-	var recording Recording
-	err = json.Unmarshal(body, &recording)
+// GetReleaseByID retrieves a release by its ID using DefaultClient
+func GetReleaseByID(id string, opts ...RequestOption) (*Release, error) {
+	return DefaultClient.GetReleaseByID(context.Background(), MBID(id), opts...)
+}
+
+// SearchRecordings searches for recordings by their title using DefaultClient
+func SearchRecordings(title string, limit int) ([]Recording, error) {
+	result, err := DefaultClient.SearchRecordings(context.Background(), title, limit)
 	if err != nil {
 		return nil, err
 	}
+	return result.Recordings, nil
+}
 
-	return &recording, nil
+// GetRecordingByID retrieves a recording by its ID using DefaultClient
+func GetRecordingByID(id string, opts ...RequestOption) (*Recording, error) {
+	return DefaultClient.GetRecordingByID(context.Background(), MBID(id), opts...)
+}
+
+// SearchRecordingsByTitleAndArtist searches for recordings by song title and
+// artist name using DefaultClient
+func SearchRecordingsByTitleAndArtist(title, artist string) ([]Recording, error) {
+	return DefaultClient.SearchRecordingsByTitleAndArtist(context.Background(), title, artist)
+}
+
+// GetTagsByTitleAndArtistAndAlbum retrieves the tags and first release date of
+// the best matching recording using DefaultClient
+func GetTagsByTitleAndArtistAndAlbum(title, artist string, album string) ([]Tag, string, error) {
+	return DefaultClient.GetTagsByTitleAndArtistAndAlbum(context.Background(), title, artist, album)
+}
+
+// GetRecordingByIDWithTags retrieves a recording and its tags by its ID using
+// DefaultClient
+func GetRecordingByIDWithTags(id string) (*Recording, error) {
+	return DefaultClient.GetRecordingByIDWithTags(context.Background(), MBID(id))
 }
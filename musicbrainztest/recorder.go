@@ -0,0 +1,170 @@
+package musicbrainztest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"unicode/utf8"
+)
+
+// Mode selects whether a Recorder records or replays interactions
+type Mode int
+
+const (
+	// ModeReplay answers requests from the recorded interactions only,
+	// failing those that weren't recorded
+	ModeReplay Mode = iota
+	// ModeRecord sends every request and records the interaction,
+	// overwriting any previous recording
+	ModeRecord
+	// ModeRecordMissing replays recorded interactions and records the
+	// others
+	ModeRecordMissing
+)
+
+// ErrNotRecorded is returned in ModeReplay for requests that weren't
+// recorded
+var ErrNotRecorded = errors.New("musicbrainztest: interaction not recorded")
+
+// Recorder is an http.RoundTripper recording HTTP interactions to golden
+// files and replaying them, so that tests can be written against the live
+// API once and then run offline and deterministically. Pass it to
+// musicbrainz.WithHTTPClient:
+//
+//	recorder := musicbrainztest.NewRecorder("testdata/fixtures", musicbrainztest.ModeReplay)
+//	client, err := musicbrainz.NewClient(musicbrainz.WithHTTPClient(&http.Client{Transport: recorder}))
+//
+// Interactions are keyed by method, URL and request body, each stored in a
+// JSON file of dir. Authorization headers aren't recorded.
+type Recorder struct {
+	dir       string
+	mode      Mode
+	transport http.RoundTripper
+}
+
+// NewRecorder returns a Recorder storing interactions in dir. Requests sent
+// in the recording modes go through http.DefaultTransport.
+func NewRecorder(dir string, mode Mode) *Recorder {
+	return &Recorder{dir: dir, mode: mode, transport: http.DefaultTransport}
+}
+
+// interaction is the content of a golden file
+type interaction struct {
+	Request struct {
+		Method string `json:"method"`
+		URL    string `json:"url"`
+		Body   string `json:"body,omitempty"`
+	} `json:"request"`
+	Response struct {
+		StatusCode int         `json:"status_code"`
+		Header     http.Header `json:"header"`
+		// Body holds text bodies and BinaryBody the others, base64-encoded
+		Body       string `json:"body,omitempty"`
+		BinaryBody []byte `json:"binary_body,omitempty"`
+	} `json:"response"`
+}
+
+// RoundTrip replays or records the interaction of request
+func (r *Recorder) RoundTrip(request *http.Request) (*http.Response, error) {
+	var body []byte
+	if request.Body != nil {
+		var err error
+		body, err = io.ReadAll(request.Body)
+		request.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		request.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	path := r.path(request, body)
+
+	if r.mode != ModeRecord {
+		recorded, err := os.ReadFile(path)
+		if err == nil {
+			var i interaction
+			if err := json.Unmarshal(recorded, &i); err != nil {
+				return nil, fmt.Errorf("musicbrainztest: %s: %w", path, err)
+			}
+			return i.response(request), nil
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+		if r.mode == ModeReplay {
+			return nil, fmt.Errorf("%w: %s %s", ErrNotRecorded, request.Method, request.URL)
+		}
+	}
+
+	response, err := r.transport.RoundTrip(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var i interaction
+	i.Request.Method = request.Method
+	i.Request.URL = request.URL.String()
+	i.Request.Body = string(body)
+	i.Response.StatusCode = response.StatusCode
+	i.Response.Header = response.Header.Clone()
+	i.Response.Header.Del("Set-Cookie")
+	if utf8.Valid(responseBody) {
+		i.Response.Body = string(responseBody)
+	} else {
+		i.Response.BinaryBody = responseBody
+	}
+	if err := r.save(path, &i); err != nil {
+		return nil, err
+	}
+	return i.response(request), nil
+}
+
+// path returns the golden file of the interaction of request
+func (r *Recorder) path(request *http.Request, body []byte) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s %s\n", request.Method, request.URL)
+	h.Write(body)
+	return filepath.Join(r.dir, hex.EncodeToString(h.Sum(nil))[:16]+".json")
+}
+
+// save writes an interaction to its golden file
+func (r *Recorder) save(path string, i *interaction) error {
+	data, err := json.MarshalIndent(i, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(r.dir, 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// response returns the recorded response to request
+func (i *interaction) response(request *http.Request) *http.Response {
+	body := i.Response.BinaryBody
+	if body == nil {
+		body = []byte(i.Response.Body)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", i.Response.StatusCode, http.StatusText(i.Response.StatusCode)),
+		StatusCode:    i.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        i.Response.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       request,
+	}
+}
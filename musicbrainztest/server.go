@@ -0,0 +1,184 @@
+// Package musicbrainztest provides a fake MusicBrainz API server for the
+// tests of programs using the musicbrainz package, so that they run without
+// network access.
+package musicbrainztest
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gcottom/musicbrainz"
+)
+
+// FakeServer serves canned WS/2 JSON documents registered by the test.
+// Lookups are answered with the document registered for the entity,
+// whatever the requested includes, and searches with the document
+// registered for the exact query, or an empty result. Anything else is
+// answered with a 404 error as MusicBrainz does. Submissions are accepted
+// and recorded.
+type FakeServer struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	documents   map[string][]byte
+	searches    map[string][]byte
+	requests    []string
+	submissions []Submission
+}
+
+// Submission is a request modifying data received by a FakeServer, such as
+// a tag or rating submission
+type Submission struct {
+	Method string
+	// Path is relative to the API root, such as "tag"
+	Path string
+	Body []byte
+}
+
+// NewFakeServer starts a FakeServer, which is closed when the test ends
+func NewFakeServer(tb testing.TB) *FakeServer {
+	s := &FakeServer{
+		documents: make(map[string][]byte),
+		searches:  make(map[string][]byte),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	tb.Cleanup(s.Close)
+	return s
+}
+
+// APIURL returns the base URL of the API served, to pass to
+// musicbrainz.WithBaseURL
+func (s *FakeServer) APIURL() string {
+	return s.URL + "/ws/2/"
+}
+
+// Client returns a client of the server, without rate limiting or retries,
+// to hand to code depending on *musicbrainz.Client or musicbrainz.MBClient.
+// opts are applied after those pointing the client at the server.
+func (s *FakeServer) Client(opts ...musicbrainz.Option) (*musicbrainz.Client, error) {
+	opts = append([]musicbrainz.Option{
+		musicbrainz.WithBaseURL(s.APIURL()),
+		musicbrainz.WithoutRateLimit(),
+		musicbrainz.WithRetry(1, 0, 0),
+	}, opts...)
+	return musicbrainz.NewClient(opts...)
+}
+
+// Add registers v, marshalled to JSON, as the response to requests for
+// path, such as "artist/<mbid>" or "discid/<disc id>". A string, []byte or
+// json.RawMessage v is served as is.
+func (s *FakeServer) Add(path string, v any) {
+	body := marshal(v)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.documents[strings.Trim(path, "/")] = body
+}
+
+// AddArtist registers an artist, served for lookups by its ID
+func (s *FakeServer) AddArtist(artist musicbrainz.Artist) {
+	s.Add("artist/"+string(artist.ID), artist)
+}
+
+// AddRecording registers a recording, served for lookups by its ID
+func (s *FakeServer) AddRecording(recording musicbrainz.Recording) {
+	s.Add("recording/"+string(recording.ID), recording)
+}
+
+// AddRelease registers a release, served for lookups by its ID
+func (s *FakeServer) AddRelease(release musicbrainz.Release) {
+	s.Add("release/"+string(release.ID), release)
+}
+
+// AddReleaseGroup registers a release group, served for lookups by its ID
+func (s *FakeServer) AddReleaseGroup(releaseGroup musicbrainz.ReleaseGroup) {
+	s.Add("release-group/"+string(releaseGroup.ID), releaseGroup)
+}
+
+// AddSearch registers v, marshalled to JSON, as the result of searches for
+// entities of the given type, such as "recording", with the exact query.
+// Search results are types such as musicbrainz.RecordingSearchResult.
+func (s *FakeServer) AddSearch(entity, query string, v any) {
+	body := marshal(v)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.searches[entity+"\x00"+query] = body
+}
+
+// Requests returns the paths and queries of the read requests received so
+// far, relative to the API root, such as "artist/<mbid>?fmt=json&inc=tags"
+func (s *FakeServer) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+// Submissions returns the submissions received so far
+func (s *FakeServer) Submissions() []Submission {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Submission(nil), s.submissions...)
+}
+
+func (s *FakeServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, "/ws/2/"), "/")
+
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		body, _ := io.ReadAll(r.Body)
+		s.mu.Lock()
+		s.submissions = append(s.submissions, Submission{Method: r.Method, Path: path, Body: body})
+		s.mu.Unlock()
+		w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+		io.WriteString(w, `<?xml version="1.0" encoding="UTF-8"?><metadata xmlns="http://musicbrainz.org/ns/mmd-2.0#"><message><text>OK</text></message></metadata>`)
+		return
+	}
+
+	s.mu.Lock()
+	request := path
+	if r.URL.RawQuery != "" {
+		request += "?" + r.URL.RawQuery
+	}
+	s.requests = append(s.requests, request)
+	var body []byte
+	var found bool
+	if query := r.URL.Query(); query.Has("query") {
+		body, found = s.searches[path+"\x00"+query.Get("query")]
+		if !found {
+			body, found = []byte(`{"created":"`+time.Now().UTC().Format(time.RFC3339)+`","count":0,"offset":0}`), true
+		}
+	} else {
+		body, found = s.documents[path]
+	}
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		io.WriteString(w, `{"error":"Not Found","help":"For usage, please see: https://musicbrainz.org/development/mmd"}`)
+		return
+	}
+	w.Write(body)
+}
+
+// marshal returns the JSON encoding of v, panicking on values that can't be
+// encoded as they are programming errors of the test
+func marshal(v any) []byte {
+	switch v := v.(type) {
+	case string:
+		return []byte(v)
+	case []byte:
+		return v
+	case json.RawMessage:
+		return v
+	}
+	body, err := json.Marshal(v)
+	if err != nil {
+		panic("musicbrainztest: " + err.Error())
+	}
+	return body
+}
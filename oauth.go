@@ -0,0 +1,250 @@
+package musicbrainz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MusicBrainzTokenURL is the OAuth2 token endpoint of MusicBrainz
+const MusicBrainzTokenURL = "https://musicbrainz.org/oauth2/token"
+
+// Token is an OAuth2 token granted by MusicBrainz
+type Token struct {
+	AccessToken  string
+	RefreshToken string
+	// Expiry is when the access token expires, or zero if unknown
+	Expiry time.Time
+}
+
+// valid reports whether the access token can be used, leaving a margin
+// before its expiry for the request to reach the server
+func (t *Token) valid() bool {
+	return t != nil && t.AccessToken != "" && (t.Expiry.IsZero() || time.Until(t.Expiry) > 10*time.Second)
+}
+
+// TokenSource supplies the OAuth2 tokens authenticating requests. Token is
+// called before every request and must return a valid token; sources are
+// expected to cache tokens until they expire. TokenSource must be safe for
+// concurrent use.
+type TokenSource interface {
+	Token(ctx context.Context) (*Token, error)
+}
+
+// TokenInvalidator is implemented by TokenSources able to replace a token
+// rejected by the server. When a request is answered with 401 Unauthorized,
+// Invalidate is called with the rejected token and the request is retried
+// once with the next token returned by Token.
+type TokenInvalidator interface {
+	Invalidate(token *Token)
+}
+
+// WithOAuthToken authenticates requests to the MusicBrainz API with an
+// OAuth2 access token, as an alternative to WithCredentials. The token is not
+// refreshed; use WithTokenSource for long-running clients.
+func WithOAuthToken(accessToken string) Option {
+	return func(c *Client) error {
+		if accessToken == "" {
+			return errors.New("musicbrainz: empty OAuth token")
+		}
+		c.tokenSource = staticTokenSource{token: &Token{AccessToken: accessToken}}
+		return nil
+	}
+}
+
+// WithTokenSource authenticates requests to the MusicBrainz API with the
+// OAuth2 tokens of source, as an alternative to WithCredentials. Tokens are
+// only sent to the host of the base URL. With a source implementing
+// TokenInvalidator, such as one returned by NewRefreshTokenSource, a token
+// rejected by the server is replaced and the request retried once.
+func WithTokenSource(source TokenSource) Option {
+	return func(c *Client) error {
+		if source == nil {
+			return errors.New("musicbrainz: nil token source")
+		}
+		c.tokenSource = source
+		return nil
+	}
+}
+
+type staticTokenSource struct {
+	token *Token
+}
+
+func (s staticTokenSource) Token(context.Context) (*Token, error) {
+	return s.token, nil
+}
+
+// OAuthConfig identifies an application registered with MusicBrainz for
+// refreshing OAuth2 tokens
+type OAuthConfig struct {
+	ClientID     string
+	ClientSecret string
+	// TokenURL defaults to MusicBrainzTokenURL
+	TokenURL string
+	// HTTPClient defaults to http.DefaultClient
+	HTTPClient *http.Client
+}
+
+// NewRefreshTokenSource returns a TokenSource starting with token and using
+// its refresh token to obtain a new access token whenever it expires or is
+// rejected by the server
+func NewRefreshTokenSource(config OAuthConfig, token *Token) TokenSource {
+	return &refreshTokenSource{config: config, token: token}
+}
+
+// refreshTokenSource is a TokenSource refreshing its token with the
+// refresh_token grant
+type refreshTokenSource struct {
+	config OAuthConfig
+	mu     sync.Mutex
+	token  *Token
+}
+
+func (s *refreshTokenSource) Token(ctx context.Context) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token.valid() {
+		return s.token, nil
+	}
+	if s.token == nil || s.token.RefreshToken == "" {
+		return nil, errors.New("musicbrainz: OAuth token expired and can't be refreshed")
+	}
+
+	token, err := s.refresh(ctx, s.token.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	s.token = token
+	return token, nil
+}
+
+// Invalidate discards token after the server rejected it, so that the next
+// call to Token refreshes it
+func (s *refreshTokenSource) Invalidate(token *Token) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token != nil && s.token.AccessToken == token.AccessToken {
+		s.token = &Token{RefreshToken: s.token.RefreshToken}
+	}
+}
+
+// refresh obtains a new access token with refreshToken
+func (s *refreshTokenSource) refresh(ctx context.Context, refreshToken string) (*Token, error) {
+	tokenURL := s.config.TokenURL
+	if tokenURL == "" {
+		tokenURL = MusicBrainzTokenURL
+	}
+	httpClient := s.config.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "refresh_token")
+	form.Set("refresh_token", refreshToken)
+	form.Set("client_id", s.config.ClientID)
+	form.Set("client_secret", s.config.ClientSecret)
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(response.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("musicbrainz: refreshing OAuth token: %d %s: %s", response.StatusCode, http.StatusText(response.StatusCode), strings.TrimSpace(string(body)))
+	}
+
+	var result struct {
+		AccessToken  string `json:"access_token"`
+		RefreshToken string `json:"refresh_token"`
+		ExpiresIn    int    `json:"expires_in"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, err
+	}
+	if result.AccessToken == "" {
+		return nil, errors.New("musicbrainz: refreshing OAuth token: no access token granted")
+	}
+	token := &Token{AccessToken: result.AccessToken, RefreshToken: result.RefreshToken}
+	if token.RefreshToken == "" {
+		token.RefreshToken = refreshToken
+	}
+	if result.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(result.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
+
+// bearerTransport is an http.RoundTripper authenticating the requests to a
+// host with the OAuth2 tokens of a TokenSource
+type bearerTransport struct {
+	base   http.RoundTripper
+	host   string
+	source TokenSource
+}
+
+func (t *bearerTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	if request.URL.Host != t.host {
+		return t.base.RoundTrip(request)
+	}
+
+	token, err := t.source.Token(request.Context())
+	if err != nil {
+		return nil, err
+	}
+	authenticated, err := withBearer(request, token)
+	if err != nil {
+		return nil, err
+	}
+	response, err := t.base.RoundTrip(authenticated)
+	if err != nil || response.StatusCode != http.StatusUnauthorized {
+		return response, err
+	}
+
+	invalidator, ok := t.source.(TokenInvalidator)
+	if !ok || request.Body != nil && request.GetBody == nil {
+		return response, nil
+	}
+	io.Copy(io.Discard, io.LimitReader(response.Body, 64<<10))
+	response.Body.Close()
+
+	invalidator.Invalidate(token)
+	if token, err = t.source.Token(request.Context()); err != nil {
+		return nil, err
+	}
+	if authenticated, err = withBearer(request, token); err != nil {
+		return nil, err
+	}
+	return t.base.RoundTrip(authenticated)
+}
+
+// withBearer returns a copy of request carrying the access token of token
+func withBearer(request *http.Request, token *Token) (*http.Request, error) {
+	authenticated := request.Clone(request.Context())
+	if request.GetBody != nil {
+		body, err := request.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		authenticated.Body = body
+	}
+	authenticated.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	return authenticated, nil
+}
@@ -0,0 +1,84 @@
+package musicbrainz
+
+import (
+	"context"
+	"net/url"
+	"strings"
+)
+
+// Call describes an API call, which is made of one or more HTTP attempts
+// when retried
+type Call struct {
+	Method string
+	// URL is the URL of the call, with the query values redacted as for
+	// WithLogger
+	URL string
+	// Endpoint is the entity type or resource requested, such as "artist"
+	// or "discid", or "coverart" and "wikidata" for the calls to these
+	// services
+	Endpoint string
+	// ID is the MBID of the entity looked up, if any
+	ID MBID
+	// Includes are the subqueries requested with WithIncludes
+	Includes []string
+}
+
+// CallResult is the outcome of a Call
+type CallResult struct {
+	// StatusCode is the status of the last attempt, or 0 if it failed
+	// without a response
+	StatusCode int
+	// Attempts is the number of HTTP attempts made, retries included
+	Attempts int
+	// Err is the error that prevented a response, if any. Error statuses
+	// are reported through StatusCode.
+	Err error
+}
+
+// CallObserver is notified of the API calls of a client that reach the
+// network, for tracing or metrics. Calls answered from the cache are not
+// observed.
+type CallObserver interface {
+	// StartCall is called before the first attempt of a call. The returned
+	// context is used for the call, such as one carrying a span, and the
+	// returned function is called with the result once the call is over.
+	StartCall(ctx context.Context, call *Call) (context.Context, func(CallResult))
+}
+
+// WithCallObserver adds an observer notified of each API call of the
+// client, such as the tracer of the otelmusicbrainz module
+func WithCallObserver(observer CallObserver) Option {
+	return func(c *Client) error {
+		c.observers = append(c.observers, observer)
+		return nil
+	}
+}
+
+// describeCall returns the Call of the given method to u
+func (c *Client) describeCall(method string, u *url.URL) *Call {
+	call := &Call{Method: method, URL: redactURL(u)}
+	rawURL := u.String()
+	switch {
+	case strings.HasPrefix(rawURL, c.coverArtURL):
+		call.Endpoint = "coverart"
+		return call
+	case strings.HasPrefix(rawURL, c.wikidataURL):
+		call.Endpoint = "wikidata"
+		return call
+	case !strings.HasPrefix(rawURL, c.baseURL):
+		call.Endpoint = u.Host
+		return call
+	}
+
+	base, err := url.Parse(c.baseURL)
+	if err != nil {
+		return call
+	}
+	path := strings.SplitN(strings.Trim(strings.TrimPrefix(u.Path, base.Path), "/"), "/", 3)
+	call.Endpoint = path[0]
+	if len(path) > 1 && MBID(path[1]).Valid() {
+		call.ID = MBID(path[1])
+	}
+	call.Includes = strings.Fields(u.Query().Get("inc"))
+	return call
+}
@@ -0,0 +1,183 @@
+package musicbrainz
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// RequestOption customizes a single API call
+type RequestOption func(*requestOptions)
+
+// requestOptions holds the settings collected from RequestOptions
+type requestOptions struct {
+	includes           []string
+	limit              int
+	offset             int
+	statuses           []string
+	types              []string
+	dismax             bool
+	thumbnailSize      ThumbnailSize
+	toc                string
+	allMediaFormats    bool
+	raw                *json.RawMessage
+	concurrency        int
+	editor             string
+	duration           time.Duration
+	releasePreferences ReleasePreferences
+}
+
+func collectOptions(opts []RequestOption) requestOptions {
+	var o requestOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// WithIncludes requests subqueries and relationships to be included in a
+// lookup, such as "aliases", "tags", "artist-credits", "isrcs" or "url-rels".
+// Includes are validated against the type of the entity looked up.
+func WithIncludes(includes ...string) RequestOption {
+	return func(o *requestOptions) {
+		o.includes = append(o.includes, includes...)
+	}
+}
+
+// WithRelationships requests the relationships of a looked up entity to
+// entities of the given types, such as "artist", "url", "work" or
+// "recording". It is shorthand for the matching "-rels" includes.
+func WithRelationships(targetTypes ...string) RequestOption {
+	return func(o *requestOptions) {
+		for _, targetType := range targetTypes {
+			o.includes = append(o.includes, targetType+"-rels")
+		}
+	}
+}
+
+// WithLimit sets the maximum number of entities returned by a browse
+// request, up to 100
+func WithLimit(limit int) RequestOption {
+	return func(o *requestOptions) {
+		o.limit = limit
+	}
+}
+
+// WithOffset sets the number of entities a browse request skips, for paging
+// through results
+func WithOffset(offset int) RequestOption {
+	return func(o *requestOptions) {
+		o.offset = offset
+	}
+}
+
+// WithStatus restricts browsed releases to the given statuses, such as
+// "official" or "bootleg"
+func WithStatus(statuses ...string) RequestOption {
+	return func(o *requestOptions) {
+		o.statuses = append(o.statuses, statuses...)
+	}
+}
+
+// WithType restricts browsed releases and release groups to the given
+// primary or secondary types, such as "album", "single", "ep" or "live"
+func WithType(types ...string) RequestOption {
+	return func(o *requestOptions) {
+		o.types = append(o.types, types...)
+	}
+}
+
+// WithDismax makes a search treat its query as plain user input rather than
+// Lucene syntax, searching the most relevant fields of the entity. Special
+// characters in the query then need no escaping.
+func WithDismax() RequestOption {
+	return func(o *requestOptions) {
+		o.dismax = true
+	}
+}
+
+// WithThumbnailSize makes a cover image download fetch a thumbnail of the
+// given size instead of the full-size image, which can weigh several
+// megabytes
+func WithThumbnailSize(size ThumbnailSize) RequestOption {
+	return func(o *requestOptions) {
+		o.thumbnailSize = size
+	}
+}
+
+// WithTOC sets the table of contents of a CD for a disc ID lookup, as
+// "first-track last-track leadout-offset track-offsets...". When no release
+// has the disc ID, releases with a similar TOC are returned instead.
+func WithTOC(toc string) RequestOption {
+	return func(o *requestOptions) {
+		o.toc = toc
+	}
+}
+
+// WithAllMediaFormats makes a disc ID lookup match releases on media of any
+// format rather than only CDs
+func WithAllMediaFormats() RequestOption {
+	return func(o *requestOptions) {
+		o.allMediaFormats = true
+	}
+}
+
+// WithRawResponse stores the JSON document returned by the API into raw, in
+// addition to decoding it, giving access to fields this package doesn't model
+// yet
+func WithRawResponse(raw *json.RawMessage) RequestOption {
+	return func(o *requestOptions) {
+		o.raw = raw
+	}
+}
+
+// WithConcurrency sets the number of lookups a batch lookup such as
+// GetRecordingsByIDs runs at once
+func WithConcurrency(n int) RequestOption {
+	return func(o *requestOptions) {
+		o.concurrency = n
+	}
+}
+
+// WithEditor sets the MusicBrainz editor whose collections are listed by
+// GetUserCollections
+func WithEditor(editor string) RequestOption {
+	return func(o *requestOptions) {
+		o.editor = editor
+	}
+}
+
+// WithDuration gives the length of the recording sought by
+// FindBestRecording, which then prefers candidates of a similar length
+func WithDuration(duration time.Duration) RequestOption {
+	return func(o *requestOptions) {
+		o.duration = duration
+	}
+}
+
+// WithReleasePreferences sets the order in which GetCanonicalRelease prefers
+// release statuses, countries and formats
+func WithReleasePreferences(preferences ReleasePreferences) RequestOption {
+	return func(o *requestOptions) {
+		o.releasePreferences = preferences
+	}
+}
+
+// target returns the value a response is decoded into, capturing the raw
+// document if requested
+func (o requestOptions) target(v any) any {
+	if o.raw == nil {
+		return v
+	}
+	return &rawCapture{raw: o.raw, v: v}
+}
+
+// rawCapture decodes a JSON document into v and keeps a copy of it in raw
+type rawCapture struct {
+	raw *json.RawMessage
+	v   any
+}
+
+func (r *rawCapture) UnmarshalJSON(data []byte) error {
+	*r.raw = append(json.RawMessage(nil), data...)
+	return json.Unmarshal(data, r.v)
+}
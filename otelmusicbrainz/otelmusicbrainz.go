@@ -0,0 +1,74 @@
+// Package otelmusicbrainz traces the API calls of a musicbrainz.Client with
+// OpenTelemetry. It is a module of its own so that the musicbrainz module
+// doesn't depend on OpenTelemetry.
+package otelmusicbrainz
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gcottom/musicbrainz"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName identifies the spans of this package
+const instrumentationName = "github.com/gcottom/musicbrainz/otelmusicbrainz"
+
+// Span attributes specific to MusicBrainz calls
+const (
+	EndpointKey = attribute.Key("musicbrainz.endpoint")
+	MBIDKey     = attribute.Key("musicbrainz.mbid")
+	IncludesKey = attribute.Key("musicbrainz.includes")
+	AttemptsKey = attribute.Key("musicbrainz.attempts")
+)
+
+// WithTracerProvider returns a client option creating a span for each API
+// call with the tracer provider, or the global one if nil. Spans are named
+// after the endpoint, such as "musicbrainz artist", and carry the MBID,
+// includes, status code and number of attempts of the call.
+func WithTracerProvider(provider trace.TracerProvider) musicbrainz.Option {
+	if provider == nil {
+		provider = otel.GetTracerProvider()
+	}
+	return musicbrainz.WithCallObserver(&tracer{tracer: provider.Tracer(instrumentationName)})
+}
+
+// tracer is a musicbrainz.CallObserver creating spans
+type tracer struct {
+	tracer trace.Tracer
+}
+
+func (t *tracer) StartCall(ctx context.Context, call *musicbrainz.Call) (context.Context, func(musicbrainz.CallResult)) {
+	attrs := []attribute.KeyValue{
+		attribute.String("http.request.method", call.Method),
+		attribute.String("url.full", call.URL),
+		EndpointKey.String(call.Endpoint),
+	}
+	if call.ID != "" {
+		attrs = append(attrs, MBIDKey.String(string(call.ID)))
+	}
+	if len(call.Includes) > 0 {
+		attrs = append(attrs, IncludesKey.StringSlice(call.Includes))
+	}
+	ctx, span := t.tracer.Start(ctx, "musicbrainz "+call.Endpoint,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...))
+
+	return ctx, func(result musicbrainz.CallResult) {
+		span.SetAttributes(AttemptsKey.Int(result.Attempts))
+		if result.StatusCode != 0 {
+			span.SetAttributes(attribute.Int("http.response.status_code", result.StatusCode))
+		}
+		switch {
+		case result.Err != nil:
+			span.RecordError(result.Err)
+			span.SetStatus(codes.Error, result.Err.Error())
+		case result.StatusCode >= http.StatusBadRequest:
+			span.SetStatus(codes.Error, http.StatusText(result.StatusCode))
+		}
+		span.End()
+	}
+}
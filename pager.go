@@ -0,0 +1,125 @@
+package musicbrainz
+
+import "context"
+
+const (
+	// browsePageSize is the largest page browse requests serve
+	browsePageSize = 100
+	// searchPageSize is the largest page search requests serve
+	searchPageSize = 100
+)
+
+// Pager pages through the results of a request transparently, fetching a
+// new page whenever the current one is exhausted. Every page goes through
+// the Client, so paging respects its rate limit.
+//
+//	pager := client.BrowseAllReleasesByArtist(ctx, artistID)
+//	for pager.Next() {
+//		release := pager.Item()
+//		...
+//	}
+//	if err := pager.Err(); err != nil {
+//		...
+//	}
+type Pager[T any] struct {
+	ctx    context.Context
+	fetch  func(ctx context.Context, offset int) ([]T, int, error)
+	items  []T
+	index  int
+	offset int
+	count  int
+	max    int
+	seen   int
+	done   bool
+	item   T
+	err    error
+}
+
+// newPager returns a Pager calling fetch with the offset of each page. fetch
+// returns the items of the page and the total number of items.
+func newPager[T any](ctx context.Context, fetch func(ctx context.Context, offset int) ([]T, int, error)) *Pager[T] {
+	return &Pager[T]{ctx: ctx, fetch: fetch, count: -1}
+}
+
+// Next advances to the next item, fetching the next page if needed. It
+// returns false when the items are exhausted or an error occurred.
+func (p *Pager[T]) Next() bool {
+	if p.err != nil || p.max > 0 && p.seen >= p.max {
+		return false
+	}
+	if p.index >= len(p.items) {
+		if p.done {
+			return false
+		}
+		items, count, err := p.fetch(p.ctx, p.offset)
+		if err != nil {
+			p.err = err
+			return false
+		}
+		p.items, p.index, p.count = items, 0, count
+		p.offset += len(items)
+		if len(items) == 0 || p.offset >= count {
+			p.done = true
+		}
+		if len(items) == 0 {
+			return false
+		}
+	}
+	p.item = p.items[p.index]
+	p.index++
+	p.seen++
+	return true
+}
+
+// Item returns the current item
+func (p *Pager[T]) Item() T {
+	return p.item
+}
+
+// Err returns the error that stopped the pager, if any
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// Count returns the total number of items reported by the last page fetched,
+// or -1 before the first page is fetched
+func (p *Pager[T]) Count() int {
+	return p.count
+}
+
+// Collect consumes the remaining items and returns them
+func (p *Pager[T]) Collect() ([]T, error) {
+	var items []T
+	for p.Next() {
+		items = append(items, p.Item())
+	}
+	return items, p.Err()
+}
+
+// searchPager returns a Pager over at most maxResults results of a search.
+// fetch runs the search for a page of the given size at offset.
+func searchPager[T any](ctx context.Context, maxResults int, fetch func(ctx context.Context, limit, offset int) ([]T, int, error)) *Pager[T] {
+	p := newPager(ctx, func(ctx context.Context, offset int) ([]T, int, error) {
+		limit := searchPageSize
+		if maxResults > 0 && maxResults-offset < limit {
+			limit = maxResults - offset
+		}
+		return fetch(ctx, limit, offset)
+	})
+	p.max = maxResults
+	return p
+}
+
+// pageOptions returns opts requesting the page at offset. Full pages are
+// requested unless opts set a smaller limit.
+func pageOptions(opts []RequestOption, offset int) []RequestOption {
+	paged := make([]RequestOption, 0, len(opts)+2)
+	paged = append(paged, WithLimit(browsePageSize))
+	paged = append(paged, opts...)
+	return append(paged, WithOffset(offset))
+}
+
+// withOffset returns a copy of opts requesting results from offset
+func withOffset(opts []RequestOption, offset int) []RequestOption {
+	return append(opts[:len(opts):len(opts)], WithOffset(offset))
+}
@@ -0,0 +1,21 @@
+//go:build go1.23
+
+package musicbrainz
+
+import "iter"
+
+// All returns an iterator over the remaining items. Iteration stops after
+// yielding the error that stopped the pager, if any.
+func (p *Pager[T]) All() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for p.Next() {
+			if !yield(p.Item(), nil) {
+				return
+			}
+		}
+		if err := p.Err(); err != nil {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package musicbrainz
+
+import (
+	"context"
+	"sort"
+	"strings"
+)
+
+// ReleasePicker selects the best release among candidates, such as the
+// releases of a release group or search results, after the release
+// preferences of MusicBrainz Picard. Releases are ranked by status, then by
+// the kind of their release group, studio albums first and compilations
+// last, then by country and format, and finally by date. The zero value
+// prefers the earliest official release.
+type ReleasePicker struct {
+	ReleasePreferences
+	// Latest prefers the latest release among those equal on every
+	// preference rather than the earliest one, such as the latest remaster
+	Latest bool
+}
+
+// Pick returns the preferred release, or nil if releases is empty. The order
+// of releases is left unchanged.
+func (p *ReleasePicker) Pick(releases []Release) *Release {
+	if len(releases) == 0 {
+		return nil
+	}
+	picker := p.withDefaults()
+	best := 0
+	for i := 1; i < len(releases); i++ {
+		if picker.less(&releases[i], &releases[best]) {
+			best = i
+		}
+	}
+	return &releases[best]
+}
+
+// Sort returns a copy of releases sorted from the most to the least
+// preferred
+func (p *ReleasePicker) Sort(releases []Release) []Release {
+	picker := p.withDefaults()
+	sorted := append([]Release(nil), releases...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return picker.less(&sorted[i], &sorted[j])
+	})
+	return sorted
+}
+
+// withDefaults returns a copy of the picker preferring official releases
+// when no status is set
+func (p *ReleasePicker) withDefaults() *ReleasePicker {
+	picker := *p
+	if len(picker.Statuses) == 0 {
+		picker.Statuses = []ReleaseStatus{ReleaseStatusOfficial}
+	}
+	return &picker
+}
+
+// PickRelease browses the releases of a release group and returns the one
+// preferred by picker, such as the edition to tag an album with
+func (c *Client) PickRelease(ctx context.Context, releaseGroupID MBID, picker ReleasePicker) (*Release, error) {
+	releases, err := c.BrowseAllReleasesByReleaseGroup(ctx, releaseGroupID, WithIncludes("media", "release-groups")).Collect()
+	if err != nil {
+		return nil, err
+	}
+	if len(releases) == 0 {
+		return nil, ErrNotFound
+	}
+
+	return picker.Pick(releases), nil
+}
+
+// less reports whether a is preferred over b
+func (p *ReleasePicker) less(a, b *Release) bool {
+	keys := [][2]int{
+		{p.statusRank(a), p.statusRank(b)},
+		{releaseGroupRank(&a.ReleaseGroup), releaseGroupRank(&b.ReleaseGroup)},
+		{preferenceRank(p.Countries, a.Country), preferenceRank(p.Countries, b.Country)},
+		{p.formatRank(a), p.formatRank(b)},
+	}
+	for _, key := range keys {
+		if key[0] != key[1] {
+			return key[0] < key[1]
+		}
+	}
+
+	// Unknown dates come last rather than first
+	if a.Date.IsZero() || b.Date.IsZero() {
+		return !a.Date.IsZero() && b.Date.IsZero()
+	}
+	if p.Latest {
+		return b.Date.Before(a.Date)
+	}
+	return a.Date.Before(b.Date)
+}
+
+func (p *ReleasePicker) statusRank(release *Release) int {
+	statuses := make([]string, len(p.Statuses))
+	for i, status := range p.Statuses {
+		statuses[i] = string(status)
+	}
+	return preferenceRank(statuses, string(release.Status))
+}
+
+// formatRank returns the rank of the most preferred format among the media
+// of release
+func (p *ReleasePicker) formatRank(release *Release) int {
+	best := len(p.Formats)
+	for _, medium := range release.Media {
+		if rank := preferenceRank(p.Formats, medium.Format); rank < best {
+			best = rank
+		}
+	}
+	return best
+}
+
+// releaseGroupRank ranks studio albums first, then other albums, other
+// release groups and compilations last
+func releaseGroupRank(rg *ReleaseGroup) int {
+	switch {
+	case rg.HasSecondaryType(ReleaseGroupSecondaryTypeCompilation):
+		return 3
+	case rg.IsStudioAlbum():
+		return 0
+	case rg.PrimaryType == ReleaseGroupTypeAlbum:
+		return 1
+	}
+	return 2
+}
+
+// preferenceRank returns the index of value in preferences, or
+// len(preferences) if it is not listed
+func preferenceRank(preferences []string, value string) int {
+	for i, preference := range preferences {
+		if strings.EqualFold(preference, value) {
+			return i
+		}
+	}
+	return len(preferences)
+}
@@ -0,0 +1,74 @@
+package musicbrainz
+
+import (
+	"context"
+	"time"
+)
+
+// Place represents a place in the MusicBrainz database, such as a venue or a
+// studio
+type Place struct {
+	ID          MBID         `json:"id"`
+	RequestedID MBID         `json:"-"`
+	Name        string       `json:"name"`
+	Type        string       `json:"type"`
+	Address     string       `json:"address"`
+	Coordinates *Coordinates `json:"coordinates"`
+	Area        *Area        `json:"area"`
+	LifeSpan    LifeSpan     `json:"life-span"`
+	Disambig    string       `json:"disambiguation"`
+	Relations   []Relation   `json:"relations"`
+	Tags        []Tag        `json:"tags"`
+	Genres      []Genre      `json:"genres"`
+	UserTags    []Tag        `json:"user-tags"`
+	UserGenres  []Genre      `json:"user-genres"`
+	Score       int          `json:"score"`
+}
+
+// Coordinates represents the geographic coordinates of a place
+type Coordinates struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// PlaceSearchResult is a page of places returned by a search
+type PlaceSearchResult struct {
+	Count   int       `json:"count"`
+	Offset  int       `json:"offset"`
+	Created time.Time `json:"created"`
+	Places  []Place   `json:"places"`
+}
+
+// SearchPlaces searches for places by their name. Use WithOffset to page through
+// the results.
+func (c *Client) SearchPlaces(ctx context.Context, name string, limit int, opts ...RequestOption) (*PlaceSearchResult, error) {
+	var result PlaceSearchResult
+	if err := c.search(ctx, "place", name, limit, opts, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// SearchAllPlaces returns a Pager over the places matching a search, stopping
+// after maxResults results. A maxResults of zero pages through every result.
+func (c *Client) SearchAllPlaces(ctx context.Context, name string, maxResults int, opts ...RequestOption) *Pager[Place] {
+	return searchPager(ctx, maxResults, func(ctx context.Context, limit, offset int) ([]Place, int, error) {
+		result, err := c.SearchPlaces(ctx, name, limit, withOffset(opts, offset)...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.Places, result.Count, nil
+	})
+}
+
+// GetPlaceByID retrieves a place by its ID
+func (c *Client) GetPlaceByID(ctx context.Context, id MBID, opts ...RequestOption) (*Place, error) {
+	var place Place
+	if err := c.lookup(ctx, "place", id, opts, &place); err != nil {
+		return nil, err
+	}
+	place.RequestedID = id
+
+	return &place, nil
+}
@@ -0,0 +1,109 @@
+// Package prommusicbrainz exports metrics of the requests of a
+// musicbrainz.Client to Prometheus. It is a module of its own so that the
+// musicbrainz module doesn't depend on the Prometheus client.
+package prommusicbrainz
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gcottom/musicbrainz"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics is a musicbrainz.Metrics implementation made of Prometheus
+// collectors:
+//
+//   - musicbrainz_requests_total, the HTTP attempts by endpoint and status
+//     code, "error" for attempts failing without a response
+//   - musicbrainz_request_duration_seconds, the latency of the attempts by
+//     endpoint
+//   - musicbrainz_retries_total, the retried attempts by endpoint
+//   - musicbrainz_cache_lookups_total, the cache lookups by endpoint and
+//     result, "hit" or "miss", from which the hit ratio is computed
+//   - musicbrainz_rate_limit_wait_seconds, the time spent waiting for the
+//     rate limiter by endpoint
+type Metrics struct {
+	requests      *prometheus.CounterVec
+	duration      *prometheus.HistogramVec
+	retries       *prometheus.CounterVec
+	cacheLookups  *prometheus.CounterVec
+	rateLimitWait *prometheus.HistogramVec
+}
+
+var _ musicbrainz.Metrics = (*Metrics)(nil)
+
+// NewMetrics creates the collectors of Metrics and registers them with
+// registerer, or prometheus.DefaultRegisterer if nil. Use a registerer
+// wrapped with prometheus.WrapRegistererWith to tell the metrics of several
+// clients apart.
+func NewMetrics(registerer prometheus.Registerer) (*Metrics, error) {
+	if registerer == nil {
+		registerer = prometheus.DefaultRegisterer
+	}
+	m := &Metrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "musicbrainz_requests_total",
+			Help: "HTTP requests made to MusicBrainz and related services, by endpoint and status code.",
+		}, []string{"endpoint", "status"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "musicbrainz_request_duration_seconds",
+			Help:    "Latency of the HTTP requests, by endpoint.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"endpoint"}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "musicbrainz_retries_total",
+			Help: "HTTP requests retried after a transient failure, by endpoint.",
+		}, []string{"endpoint"}),
+		cacheLookups: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "musicbrainz_cache_lookups_total",
+			Help: "Cache lookups, by endpoint and result.",
+		}, []string{"endpoint", "result"}),
+		rateLimitWait: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "musicbrainz_rate_limit_wait_seconds",
+			Help:    "Time requests waited for the rate limiter, by endpoint.",
+			Buckets: []float64{0.001, 0.01, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+		}, []string{"endpoint"}),
+	}
+	for _, collector := range []prometheus.Collector{m.requests, m.duration, m.retries, m.cacheLookups, m.rateLimitWait} {
+		if err := registerer.Register(collector); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// WithMetrics returns a client option recording its requests with new
+// Metrics registered with registerer
+func WithMetrics(registerer prometheus.Registerer) (musicbrainz.Option, error) {
+	m, err := NewMetrics(registerer)
+	if err != nil {
+		return nil, err
+	}
+	return musicbrainz.WithMetrics(m), nil
+}
+
+func (m *Metrics) ObserveRequest(endpoint string, statusCode int, elapsed time.Duration) {
+	status := "error"
+	if statusCode != 0 {
+		status = strconv.Itoa(statusCode)
+	}
+	m.requests.WithLabelValues(endpoint, status).Inc()
+	m.duration.WithLabelValues(endpoint).Observe(elapsed.Seconds())
+}
+
+func (m *Metrics) ObserveRetry(endpoint string) {
+	m.retries.WithLabelValues(endpoint).Inc()
+}
+
+func (m *Metrics) ObserveRateLimitWait(endpoint string, wait time.Duration) {
+	m.rateLimitWait.WithLabelValues(endpoint).Observe(wait.Seconds())
+}
+
+func (m *Metrics) ObserveCache(endpoint string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	m.cacheLookups.WithLabelValues(endpoint, result).Inc()
+}
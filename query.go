@@ -0,0 +1,259 @@
+package musicbrainz
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RecordingQuery builds a Lucene query for SearchRecordings from search
+// fields. Values are quoted as phrases, so quotes, colons and parentheses in
+// titles are matched literally. Empty fields are left out of the query.
+type RecordingQuery struct {
+	Recording string        // recording title
+	Artist    string        // artist name, as credited
+	ArtistID  string        // artist MBID
+	Release   string        // title of a release the recording appears on
+	Duration  time.Duration // duration, matched to the millisecond
+	ISRC      string        // ISRC
+	Tag       string        // folksonomy tag
+	Status    string        // status of a release the recording appears on
+}
+
+// String renders the query in Lucene syntax, joining fields with AND
+func (q RecordingQuery) String() string {
+	var b queryBuilder
+	b.phrase("recording", q.Recording)
+	b.phrase("artist", q.Artist)
+	b.phrase("arid", q.ArtistID)
+	b.phrase("release", q.Release)
+	if q.Duration > 0 {
+		b.term("dur", strconv.FormatInt(q.Duration.Milliseconds(), 10))
+	}
+	b.phrase("isrc", q.ISRC)
+	b.phrase("tag", q.Tag)
+	b.phrase("status", q.Status)
+	return b.String()
+}
+
+// ArtistQuery builds a Lucene query for SearchArtists from search fields.
+// Values are quoted as phrases and empty fields are left out of the query.
+type ArtistQuery struct {
+	Name    string // artist name
+	Alias   string // alias of the artist
+	Area    string // name of the area the artist is from
+	Country string // ISO 3166-1 code of the country the artist is from
+	Type    string // artist type, such as "person" or "group"
+	Tag     string // folksonomy tag
+	Begin   string // begin date, such as "1960"
+	End     string // end date
+}
+
+// String renders the query in Lucene syntax, joining fields with AND
+func (q ArtistQuery) String() string {
+	var b queryBuilder
+	b.phrase("artist", q.Name)
+	b.phrase("alias", q.Alias)
+	b.phrase("area", q.Area)
+	b.phrase("country", q.Country)
+	b.phrase("type", q.Type)
+	b.phrase("tag", q.Tag)
+	b.phrase("begin", q.Begin)
+	b.phrase("end", q.End)
+	return b.String()
+}
+
+// ReleaseQuery builds a Lucene query for SearchReleases from search fields.
+// Values are quoted as phrases and empty fields are left out of the query.
+type ReleaseQuery struct {
+	Release       string // release title
+	Artist        string // artist name, as credited
+	Barcode       string // barcode
+	CatalogNumber string // catalog number
+	Label         string // label name
+	Format        string // medium format, such as "CD"
+	Date          string // release date, such as "1992" or "1992-06-22"
+	Country       string // ISO 3166-1 code of the release country
+	Status        string // release status, such as "official"
+}
+
+// String renders the query in Lucene syntax, joining fields with AND
+func (q ReleaseQuery) String() string {
+	var b queryBuilder
+	b.phrase("release", q.Release)
+	b.phrase("artist", q.Artist)
+	b.phrase("barcode", q.Barcode)
+	b.phrase("catno", q.CatalogNumber)
+	b.phrase("label", q.Label)
+	b.phrase("format", q.Format)
+	b.phrase("date", q.Date)
+	b.phrase("country", q.Country)
+	b.phrase("status", q.Status)
+	return b.String()
+}
+
+// Expr is a Lucene query expression. Exprs built with Field, And, Or, Not
+// and Range compose into complex queries, along with the RecordingQuery,
+// ArtistQuery and ReleaseQuery builders:
+//
+//	query := And(
+//		Field("artist", "Nirvana"),
+//		Range("date", "1990", "1995"),
+//		Not(Field("comment", "live")),
+//	)
+//	result, err := client.SearchRecordings(ctx, query.String(), 25)
+type Expr interface {
+	String() string
+}
+
+// Field returns an Expr matching value as a phrase in the given search field
+func Field(name, value string) Expr {
+	return fieldExpr{name: name, value: value}
+}
+
+// And returns an Expr matching all of exprs
+func And(exprs ...Expr) Expr {
+	return boolExpr{op: " AND ", exprs: exprs}
+}
+
+// Or returns an Expr matching any of exprs
+func Or(exprs ...Expr) Expr {
+	return boolExpr{op: " OR ", exprs: exprs}
+}
+
+// Not returns an Expr excluding matches of expr. Lucene only supports
+// exclusion alongside other clauses, for example inside And.
+func Not(expr Expr) Expr {
+	return notExpr{expr: expr}
+}
+
+// Range returns an Expr matching values of field between from and to
+// inclusive. Bounds may be strings such as dates, numbers, or
+// time.Durations, which are rendered in milliseconds. A bound of "*" or nil
+// leaves the range open.
+func Range(field string, from, to any) Expr {
+	return rangeExpr{field: field, from: from, to: to}
+}
+
+type fieldExpr struct {
+	name, value string
+}
+
+func (e fieldExpr) String() string {
+	return e.name + ":" + quoteLucene(e.value)
+}
+
+type boolExpr struct {
+	op    string
+	exprs []Expr
+}
+
+func (e boolExpr) String() string {
+	clauses := make([]string, 0, len(e.exprs))
+	for _, expr := range e.exprs {
+		if clause := group(expr); clause != "" {
+			clauses = append(clauses, clause)
+		}
+	}
+	if len(clauses) == 1 {
+		return clauses[0]
+	}
+	if len(clauses) == 0 {
+		return ""
+	}
+	return "(" + strings.Join(clauses, e.op) + ")"
+}
+
+// group renders expr so that it can be combined with other clauses. Exprs
+// such as query builders joining several clauses are parenthesized.
+func group(expr Expr) string {
+	clause := expr.String()
+	switch expr.(type) {
+	case fieldExpr, boolExpr, notExpr, rangeExpr:
+		return clause
+	}
+	if strings.Contains(clause, " ") {
+		return "(" + clause + ")"
+	}
+	return clause
+}
+
+type notExpr struct {
+	expr Expr
+}
+
+func (e notExpr) String() string {
+	clause := group(e.expr)
+	if clause == "" {
+		return ""
+	}
+	return "NOT " + clause
+}
+
+type rangeExpr struct {
+	field    string
+	from, to any
+}
+
+func (e rangeExpr) String() string {
+	return e.field + ":[" + rangeBound(e.from) + " TO " + rangeBound(e.to) + "]"
+}
+
+// rangeBound renders a bound of a range query
+func rangeBound(bound any) string {
+	switch b := bound.(type) {
+	case string:
+		if b == "*" || b == "" {
+			return "*"
+		}
+		return EscapeLucene(b)
+	case time.Duration:
+		return strconv.FormatInt(b.Milliseconds(), 10)
+	case nil:
+		return "*"
+	}
+	return EscapeLucene(fmt.Sprint(bound))
+}
+
+// queryBuilder joins Lucene field clauses with AND
+type queryBuilder struct {
+	clauses []string
+}
+
+// phrase adds a clause matching value as a phrase, unless value is empty
+func (b *queryBuilder) phrase(field, value string) {
+	if value == "" {
+		return
+	}
+	b.term(field, quoteLucene(value))
+}
+
+// term adds a clause matching an already escaped term
+func (b *queryBuilder) term(field, term string) {
+	b.clauses = append(b.clauses, field+":"+term)
+}
+
+func (b *queryBuilder) String() string {
+	return strings.Join(b.clauses, " AND ")
+}
+
+// luceneEscaper escapes the characters with a special meaning in Lucene
+// query syntax
+var luceneEscaper = strings.NewReplacer(
+	`\`, `\\`, `+`, `\+`, `-`, `\-`, `&`, `\&`, `|`, `\|`, `!`, `\!`,
+	`(`, `\(`, `)`, `\)`, `{`, `\{`, `}`, `\}`, `[`, `\[`, `]`, `\]`,
+	`^`, `\^`, `"`, `\"`, `~`, `\~`, `*`, `\*`, `?`, `\?`, `:`, `\:`,
+	`/`, `\/`,
+)
+
+// EscapeLucene escapes the Lucene special characters in value, so that
+// titles such as "What's Up? (Remix)" can be used as search terms
+func EscapeLucene(value string) string {
+	return luceneEscaper.Replace(value)
+}
+
+// quoteLucene escapes value and quotes it as a Lucene phrase
+func quoteLucene(value string) string {
+	return `"` + EscapeLucene(value) + `"`
+}
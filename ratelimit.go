@@ -0,0 +1,78 @@
+package musicbrainz
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// rateLimiter is a token bucket shared by all requests made through a Client.
+// A token is added every interval, up to burst tokens.
+type rateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	burst    int
+	tokens   float64
+	last     time.Time
+}
+
+func newRateLimiter(interval time.Duration, burst int) *rateLimiter {
+	return &rateLimiter{interval: interval, burst: burst}
+}
+
+// wait blocks until a token is available or ctx is done. A nil rateLimiter
+// never blocks.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	delay := l.reserve()
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.cancel()
+		return ctx.Err()
+	}
+}
+
+// reserve takes a token from the bucket and returns how long the caller has
+// to wait before the token is actually available
+func (l *rateLimiter) reserve() time.Duration {
+	if l == nil || l.interval <= 0 {
+		return 0
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	burst := float64(l.burst)
+	if burst < 1 {
+		burst = 1
+	}
+	if l.last.IsZero() {
+		l.tokens = burst
+	} else {
+		l.tokens += float64(now.Sub(l.last)) / float64(l.interval)
+		if l.tokens > burst {
+			l.tokens = burst
+		}
+	}
+	l.last = now
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens * float64(l.interval))
+}
+
+// cancel returns a token reserved by a caller that gave up waiting
+func (l *rateLimiter) cancel() {
+	l.mu.Lock()
+	l.tokens++
+	l.mu.Unlock()
+}
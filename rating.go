@@ -0,0 +1,41 @@
+package musicbrainz
+
+import (
+	"context"
+	"fmt"
+)
+
+// RatingSubmission is a rating of an entity by the authenticated user
+type RatingSubmission struct {
+	// EntityType is the type of the rated entity, such as "recording" or
+	// "release-group"
+	EntityType string
+	ID         MBID
+	// Rating ranges from 0 to 100, 20 per star. A rating of 0 removes the
+	// rating of the user.
+	Rating int
+}
+
+// ratableEntityTypes lists the types of entities accepting ratings
+var ratableEntityTypes = []string{
+	"artist", "event", "label", "recording", "release-group", "work",
+}
+
+// SubmitRatings submits ratings of entities by the authenticated user,
+// replacing their previous ratings. The ratings are sent in a single
+// request.
+func (c *Client) SubmitRatings(ctx context.Context, ratings []RatingSubmission) error {
+	var s submission
+	for _, rating := range ratings {
+		if rating.Rating < 0 || rating.Rating > 100 {
+			return fmt.Errorf("musicbrainz: rating %d of %s %s out of range", rating.Rating, rating.EntityType, rating.ID)
+		}
+		entity, err := s.entity(rating.EntityType, rating.ID, ratableEntityTypes)
+		if err != nil {
+			return err
+		}
+		value := rating.Rating
+		entity.UserRating = &value
+	}
+	return c.submit(ctx, "rating", &s)
+}
@@ -0,0 +1,83 @@
+package musicbrainz
+
+import "strings"
+
+// redirected reports whether an entity looked up by requested was returned
+// with a different MBID. MusicBrainz keeps serving the MBIDs of merged
+// entities, returning the entity they were merged into, so applications
+// storing MBIDs should replace requested with the returned one.
+func redirected(requested, id MBID) bool {
+	return requested != "" && !strings.EqualFold(string(requested), string(id))
+}
+
+// Redirected reports whether the area was looked up by an MBID that has been
+// merged into ID
+func (a *Area) Redirected() bool {
+	return redirected(a.RequestedID, a.ID)
+}
+
+// Redirected reports whether the artist was looked up by an MBID that has been
+// merged into ID
+func (a *Artist) Redirected() bool {
+	return redirected(a.RequestedID, a.ID)
+}
+
+// Redirected reports whether the event was looked up by an MBID that has been
+// merged into ID
+func (e *Event) Redirected() bool {
+	return redirected(e.RequestedID, e.ID)
+}
+
+// Redirected reports whether the genre was looked up by an MBID that has been
+// merged into ID
+func (g *Genre) Redirected() bool {
+	return redirected(g.RequestedID, g.ID)
+}
+
+// Redirected reports whether the instrument was looked up by an MBID that has been
+// merged into ID
+func (i *Instrument) Redirected() bool {
+	return redirected(i.RequestedID, i.ID)
+}
+
+// Redirected reports whether the place was looked up by an MBID that has been
+// merged into ID
+func (p *Place) Redirected() bool {
+	return redirected(p.RequestedID, p.ID)
+}
+
+// Redirected reports whether the recording was looked up by an MBID that has been
+// merged into ID
+func (r *Recording) Redirected() bool {
+	return redirected(r.RequestedID, r.ID)
+}
+
+// Redirected reports whether the release was looked up by an MBID that has been
+// merged into ID
+func (r *Release) Redirected() bool {
+	return redirected(r.RequestedID, r.ID)
+}
+
+// Redirected reports whether the release group was looked up by an MBID that has been
+// merged into ID
+func (rg *ReleaseGroup) Redirected() bool {
+	return redirected(rg.RequestedID, rg.ID)
+}
+
+// Redirected reports whether the series was looked up by an MBID that has been
+// merged into ID
+func (s *Series) Redirected() bool {
+	return redirected(s.RequestedID, s.ID)
+}
+
+// Redirected reports whether the URL was looked up by an MBID that has been
+// merged into ID
+func (u *URL) Redirected() bool {
+	return redirected(u.RequestedID, u.ID)
+}
+
+// Redirected reports whether the work was looked up by an MBID that has been
+// merged into ID
+func (w *Work) Redirected() bool {
+	return redirected(w.RequestedID, w.ID)
+}
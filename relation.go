@@ -0,0 +1,83 @@
+package musicbrainz
+
+// Relation represents a relationship between two entities in the MusicBrainz
+// database, such as an artist being the producer of a recording. Exactly one
+// of the target fields is set, as given by TargetType.
+type Relation struct {
+	Type            string            `json:"type"`
+	TypeID          string            `json:"type-id"`
+	TargetType      string            `json:"target-type"`
+	Direction       string            `json:"direction"`
+	Attributes      []string          `json:"attributes"`
+	AttributeValues map[string]string `json:"attribute-values"`
+	Begin           PartialDate       `json:"begin"`
+	End             PartialDate       `json:"end"`
+	Ended           bool              `json:"ended"`
+	SourceCredit    string            `json:"source-credit"`
+	TargetCredit    string            `json:"target-credit"`
+
+	Area         *Area         `json:"area"`
+	Artist       *Artist       `json:"artist"`
+	Event        *Event        `json:"event"`
+	Genre        *Genre        `json:"genre"`
+	Instrument   *Instrument   `json:"instrument"`
+	Label        *Label        `json:"label"`
+	Place        *Place        `json:"place"`
+	Recording    *Recording    `json:"recording"`
+	Release      *Release      `json:"release"`
+	ReleaseGroup *ReleaseGroup `json:"release-group"`
+	Series       *Series       `json:"series"`
+	URL          *URL          `json:"url"`
+	Work         *Work         `json:"work"`
+}
+
+// Backward reports whether the relationship points from its target to the
+// entity it was retrieved with, for example from a producer to a recording
+func (r *Relation) Backward() bool {
+	return r.Direction == "backward"
+}
+
+// HasAttribute reports whether the relationship has the given attribute, such
+// as "live" or "guest"
+func (r *Relation) HasAttribute(attribute string) bool {
+	for _, a := range r.Attributes {
+		if a == attribute {
+			return true
+		}
+	}
+	return false
+}
+
+// TargetID returns the MBID of the entity the relationship points to, or an
+// empty string if the target type is unknown
+func (r *Relation) TargetID() MBID {
+	switch {
+	case r.Area != nil:
+		return r.Area.ID
+	case r.Artist != nil:
+		return r.Artist.ID
+	case r.Event != nil:
+		return r.Event.ID
+	case r.Genre != nil:
+		return r.Genre.ID
+	case r.Instrument != nil:
+		return r.Instrument.ID
+	case r.Label != nil:
+		return r.Label.ID
+	case r.Place != nil:
+		return r.Place.ID
+	case r.Recording != nil:
+		return r.Recording.ID
+	case r.Release != nil:
+		return r.Release.ID
+	case r.ReleaseGroup != nil:
+		return r.ReleaseGroup.ID
+	case r.Series != nil:
+		return r.Series.ID
+	case r.URL != nil:
+		return r.URL.ID
+	case r.Work != nil:
+		return r.Work.ID
+	}
+	return ""
+}
@@ -0,0 +1,72 @@
+package musicbrainz
+
+import (
+	"context"
+	"time"
+)
+
+// ReleaseGroup represents a release group in the MusicBrainz database. A
+// release group gathers the different releases of the same album, single or
+// EP.
+type ReleaseGroup struct {
+	ID               MBID                        `json:"id"`
+	RequestedID      MBID                        `json:"-"`
+	Title            string                      `json:"title"`
+	Type             ReleaseGroupType            `json:"type"`
+	PrimaryType      ReleaseGroupType            `json:"primary-type"`
+	SecondaryTypes   []ReleaseGroupSecondaryType `json:"secondary-types"`
+	FirstReleaseDate PartialDate                 `json:"first-release-date"`
+	Disambig         string                      `json:"disambiguation"`
+	ArtistCredit     ArtistCredits               `json:"artist-credit"`
+	Releases         []Release                   `json:"releases"`
+	Relations        []Relation                  `json:"relations"`
+	Tags             []Tag                       `json:"tags"`
+	Genres           []Genre                     `json:"genres"`
+	UserTags         []Tag                       `json:"user-tags"`
+	UserGenres       []Genre                     `json:"user-genres"`
+	Rating           *Rating                     `json:"rating"`
+	UserRating       *Rating                     `json:"user-rating"`
+	Score            int                         `json:"score"`
+}
+
+// ReleaseGroupSearchResult is a page of release groups returned by a search
+type ReleaseGroupSearchResult struct {
+	Count         int            `json:"count"`
+	Offset        int            `json:"offset"`
+	Created       time.Time      `json:"created"`
+	ReleaseGroups []ReleaseGroup `json:"release-groups"`
+}
+
+// SearchReleaseGroups searches for release groups by their title. Use WithOffset to page through
+// the results.
+func (c *Client) SearchReleaseGroups(ctx context.Context, title string, limit int, opts ...RequestOption) (*ReleaseGroupSearchResult, error) {
+	var result ReleaseGroupSearchResult
+	if err := c.search(ctx, "release-group", title, limit, opts, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// SearchAllReleaseGroups returns a Pager over the release groups matching a search, stopping
+// after maxResults results. A maxResults of zero pages through every result.
+func (c *Client) SearchAllReleaseGroups(ctx context.Context, title string, maxResults int, opts ...RequestOption) *Pager[ReleaseGroup] {
+	return searchPager(ctx, maxResults, func(ctx context.Context, limit, offset int) ([]ReleaseGroup, int, error) {
+		result, err := c.SearchReleaseGroups(ctx, title, limit, withOffset(opts, offset)...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.ReleaseGroups, result.Count, nil
+	})
+}
+
+// GetReleaseGroupByID retrieves a release group by its ID
+func (c *Client) GetReleaseGroupByID(ctx context.Context, id MBID, opts ...RequestOption) (*ReleaseGroup, error) {
+	var releaseGroup ReleaseGroup
+	if err := c.lookup(ctx, "release-group", id, opts, &releaseGroup); err != nil {
+		return nil, err
+	}
+	releaseGroup.RequestedID = id
+
+	return &releaseGroup, nil
+}
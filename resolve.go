@@ -0,0 +1,187 @@
+package musicbrainz
+
+import (
+	"context"
+	"sort"
+	"strings"
+)
+
+// resolveCandidates is the number of search results ResolveArtist ranks
+const resolveCandidates = 25
+
+// ArtistHints narrows down the artists ResolveArtist considers the best
+// matches for a name. Empty hints are ignored.
+type ArtistHints struct {
+	// Country is the ISO 3166-1 code of the country the artist is from
+	Country string
+	// Type is the type of the artist, such as ArtistTypeGroup
+	Type ArtistType
+	// Disambig holds words expected in the disambiguation comment of the
+	// artist, such as "UK punk band"
+	Disambig string
+}
+
+// ArtistCandidate is an artist ranked by ResolveArtist
+type ArtistCandidate struct {
+	Artist Artist
+	// MatchedName is the name, sort name or alias of the artist that best
+	// matches the resolved name
+	MatchedName string
+	// Confidence ranges from 0 to 1, 1 being a perfect match on the name and
+	// every hint
+	Confidence float64
+}
+
+// ResolveArtist searches for the artists going by name, under their name,
+// sort name or an alias, and returns them ranked from the most to the least
+// likely match. A leading "The" is optional on both sides, so "Beatles"
+// resolves to The Beatles. Hints break the ties between artists sharing a
+// name. It returns ErrNotFound if the search has no results.
+func (c *Client) ResolveArtist(ctx context.Context, name string, hints ArtistHints, opts ...RequestOption) ([]ArtistCandidate, error) {
+	result, err := c.SearchArtists(ctx, artistNameQuery(name), resolveCandidates, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Artists) == 0 {
+		return nil, ErrNotFound
+	}
+
+	candidates := make([]ArtistCandidate, len(result.Artists))
+	for i := range result.Artists {
+		artist := &result.Artists[i]
+		matched, similarity := artistNameSimilarity(name, artist)
+		candidates[i] = ArtistCandidate{
+			Artist:      *artist,
+			MatchedName: matched,
+			Confidence:  scoreArtist(artist, similarity, hints),
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Confidence > candidates[j].Confidence
+	})
+	return candidates, nil
+}
+
+// artistNameQuery returns a query matching the artists whose name, sort name
+// or an alias is name, with or without a leading "The"
+func artistNameQuery(name string) string {
+	names := []string{name}
+	if stripped := stripArticle(name); stripped != name {
+		names = append(names, stripped)
+	} else {
+		names = append(names, "The "+name)
+	}
+
+	var clauses []string
+	for _, n := range names {
+		for _, field := range []string{"artist", "sortname", "alias"} {
+			clauses = append(clauses, field+":"+quoteLucene(n))
+		}
+	}
+	return strings.Join(clauses, " OR ")
+}
+
+// artistNameSimilarity returns the name, sort name or alias of artist most
+// similar to name, along with its similarity
+func artistNameSimilarity(name string, artist *Artist) (string, float64) {
+	names := []string{artist.Name, artist.SortName}
+	for _, alias := range artist.Aliases {
+		names = append(names, alias.Name, alias.SortName)
+	}
+
+	want := stripArticle(name)
+	best, bestSimilarity := artist.Name, 0.0
+	for _, n := range names {
+		if n == "" {
+			continue
+		}
+		if s := similarity(want, stripArticle(n)); s > bestSimilarity {
+			best, bestSimilarity = n, s
+		}
+	}
+	return best, bestSimilarity
+}
+
+// scoreArtist rates how well artist matches hints, given the similarity of
+// its best matching name
+func scoreArtist(artist *Artist, nameSimilarity float64, hints ArtistHints) float64 {
+	var score, weight float64
+	add := func(value, w float64) {
+		score += value * w
+		weight += w
+	}
+
+	add(nameSimilarity, 0.6)
+	add(float64(artist.Score)/100, 0.2)
+	if hints.Country != "" {
+		add(countryMatch(artist, hints.Country), 0.1)
+	}
+	if hints.Type != "" {
+		value := 0.0
+		if strings.EqualFold(string(artist.Type), string(hints.Type)) {
+			value = 1
+		}
+		add(value, 0.05)
+	}
+	if hints.Disambig != "" {
+		add(wordOverlap(hints.Disambig, artist.Disambig), 0.1)
+	}
+	return score / weight
+}
+
+// countryMatch returns 1 if artist is from country, 0 if it is from another
+// country and 0.5 if its country is unknown
+func countryMatch(artist *Artist, country string) float64 {
+	codes := []string{artist.Country}
+	if artist.Area != nil {
+		codes = append(codes, artist.Area.ISO31661Codes...)
+	}
+
+	known := false
+	for _, code := range codes {
+		if code == "" {
+			continue
+		}
+		if strings.EqualFold(code, country) {
+			return 1
+		}
+		known = true
+	}
+	if known {
+		return 0
+	}
+	return 0.5
+}
+
+// wordOverlap returns the fraction of the words of want found in have
+func wordOverlap(want, have string) float64 {
+	wanted := strings.Fields(normalizeName(want))
+	if len(wanted) == 0 {
+		return 0
+	}
+	present := make(map[string]bool)
+	for _, word := range strings.Fields(normalizeName(have)) {
+		present[word] = true
+	}
+
+	found := 0
+	for _, word := range wanted {
+		if present[word] {
+			found++
+		}
+	}
+	return float64(found) / float64(len(wanted))
+}
+
+// stripArticle removes a leading "The" from name, as well as a trailing
+// ", The" as found in sort names
+func stripArticle(name string) string {
+	trimmed := strings.TrimSpace(name)
+	if len(trimmed) > 4 && strings.EqualFold(trimmed[:4], "the ") {
+		return strings.TrimSpace(trimmed[4:])
+	}
+	if len(trimmed) > 5 && strings.EqualFold(trimmed[len(trimmed)-5:], ", the") {
+		return strings.TrimSpace(trimmed[:len(trimmed)-5])
+	}
+	return trimmed
+}
@@ -0,0 +1,94 @@
+package musicbrainz
+
+import (
+	"context"
+	"math/bits"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// retryPolicy controls how requests failing with a transient error are retried
+type retryPolicy struct {
+	maxAttempts int
+	baseDelay   time.Duration
+	maxDelay    time.Duration
+}
+
+var defaultRetryPolicy = retryPolicy{
+	maxAttempts: 3,
+	baseDelay:   time.Second,
+	maxDelay:    30 * time.Second,
+}
+
+// retryable reports whether a response with the given status code is worth retrying
+func retryable(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// delay returns how long to wait before the next attempt, given the number of
+// attempts made so far and the headers of the last response. A Retry-After
+// header takes precedence over the exponential backoff, but is capped at
+// maxDelay too so that a date far ahead doesn't block the caller.
+func (p retryPolicy) delay(attempt int, header http.Header) time.Duration {
+	if d, ok := parseRetryAfter(header.Get("Retry-After"), time.Now()); ok {
+		return min(d, p.maxDelay)
+	}
+
+	if p.baseDelay <= 0 {
+		return 0
+	}
+	// Shifting by more than the bits left above baseDelay would overflow
+	backoff := p.maxDelay
+	if shift := attempt - 1; shift < bits.LeadingZeros64(uint64(p.baseDelay))-1 {
+		backoff = min(p.baseDelay<<shift, p.maxDelay)
+	}
+	// Equal jitter: wait at least half the backoff so retries don't pile up,
+	// and spread the rest randomly so concurrent clients don't stay in sync
+	half := backoff / 2
+	if half <= 0 {
+		return backoff
+	}
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// parseRetryAfter parses a Retry-After header holding either a number of
+// seconds or an HTTP date
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if d := date.Sub(now); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// sleepContext waits for d or until ctx is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,37 @@
+package musicbrainz
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   retryPolicy
+		attempt  int
+		header   http.Header
+		min, max time.Duration
+	}{
+		{"no base delay", retryPolicy{baseDelay: 0, maxDelay: 30 * time.Second}, 1, nil, 0, 0},
+		{"no base delay later attempt", retryPolicy{baseDelay: 0, maxDelay: 30 * time.Second}, 5, nil, 0, 0},
+		{"first attempt", retryPolicy{baseDelay: time.Second, maxDelay: 30 * time.Second}, 1, nil, 500 * time.Millisecond, time.Second},
+		{"third attempt", retryPolicy{baseDelay: time.Second, maxDelay: 30 * time.Second}, 3, nil, 2 * time.Second, 4 * time.Second},
+		{"capped", retryPolicy{baseDelay: time.Second, maxDelay: 30 * time.Second}, 10, nil, 15 * time.Second, 30 * time.Second},
+		{"overflow", retryPolicy{baseDelay: time.Second, maxDelay: 30 * time.Second}, 200, nil, 15 * time.Second, 30 * time.Second},
+		{"retry after", retryPolicy{baseDelay: time.Second, maxDelay: 30 * time.Second}, 1, http.Header{"Retry-After": {"5"}}, 5 * time.Second, 5 * time.Second},
+		{"retry after capped", retryPolicy{baseDelay: time.Second, maxDelay: 30 * time.Second}, 1, http.Header{"Retry-After": {"86400"}}, 30 * time.Second, 30 * time.Second},
+		{"retry after date capped", retryPolicy{baseDelay: time.Second, maxDelay: 30 * time.Second}, 1, http.Header{"Retry-After": {time.Now().Add(48 * time.Hour).UTC().Format(http.TimeFormat)}}, 30 * time.Second, 30 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 0; i < 20; i++ {
+				d := tt.policy.delay(tt.attempt, tt.header)
+				if d < tt.min || d > tt.max {
+					t.Fatalf("delay(%d) = %v, want between %v and %v", tt.attempt, d, tt.min, tt.max)
+				}
+			}
+		})
+	}
+}
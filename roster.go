@@ -0,0 +1,58 @@
+package musicbrainz
+
+import (
+	"context"
+	"sort"
+)
+
+// LabelArtist is an artist credited on releases of a label
+type LabelArtist struct {
+	Artist       Artist
+	ReleaseCount int
+}
+
+// GetLabelArtists browses every release of a label and returns the distinct
+// artists credited on them, with the number of releases of each, most
+// prolific first. Various Artists is left out. Options such as WithStatus
+// are passed on to the browse requests.
+func (c *Client) GetLabelArtists(ctx context.Context, labelID MBID, opts ...RequestOption) ([]LabelArtist, error) {
+	opts = append([]RequestOption{WithIncludes("artist-credits")}, opts...)
+	releases, err := c.BrowseAllReleasesByLabel(ctx, labelID, opts...).Collect()
+	if err != nil {
+		return nil, err
+	}
+
+	var roster []LabelArtist
+	index := make(map[MBID]int)
+	for _, release := range releases {
+		for _, id := range release.ArtistCredit.IDs() {
+			if id == VariousArtistsID {
+				continue
+			}
+			i, ok := index[id]
+			if !ok {
+				i = len(roster)
+				index[id] = i
+				roster = append(roster, LabelArtist{Artist: creditedArtist(release.ArtistCredit, id)})
+			}
+			roster[i].ReleaseCount++
+		}
+	}
+	sort.SliceStable(roster, func(i, j int) bool {
+		if roster[i].ReleaseCount != roster[j].ReleaseCount {
+			return roster[i].ReleaseCount > roster[j].ReleaseCount
+		}
+		return roster[i].Artist.SortName < roster[j].Artist.SortName
+	})
+	return roster, nil
+}
+
+// creditedArtist returns the artist with the given ID in credits
+func creditedArtist(credits ArtistCredits, id MBID) Artist {
+	for _, credit := range credits {
+		if credit.Artist.ID == id {
+			return credit.Artist
+		}
+	}
+	return Artist{ID: id}
+}
@@ -0,0 +1,64 @@
+package musicbrainz
+
+import (
+	"context"
+	"time"
+)
+
+// Series represents a sequence of related entities in the MusicBrainz
+// database, such as a tour or a catalogue of works
+type Series struct {
+	ID          MBID       `json:"id"`
+	RequestedID MBID       `json:"-"`
+	Name        string     `json:"name"`
+	Type        string     `json:"type"`
+	Disambig    string     `json:"disambiguation"`
+	Relations   []Relation `json:"relations"`
+	Tags        []Tag      `json:"tags"`
+	Genres      []Genre    `json:"genres"`
+	UserTags    []Tag      `json:"user-tags"`
+	UserGenres  []Genre    `json:"user-genres"`
+	Score       int        `json:"score"`
+}
+
+// SeriesSearchResult is a page of series returned by a search
+type SeriesSearchResult struct {
+	Count   int       `json:"count"`
+	Offset  int       `json:"offset"`
+	Created time.Time `json:"created"`
+	Series  []Series  `json:"series"`
+}
+
+// SearchSeries searches for series by their name. Use WithOffset to page through
+// the results.
+func (c *Client) SearchSeries(ctx context.Context, name string, limit int, opts ...RequestOption) (*SeriesSearchResult, error) {
+	var result SeriesSearchResult
+	if err := c.search(ctx, "series", name, limit, opts, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// SearchAllSeries returns a Pager over the series matching a search, stopping
+// after maxResults results. A maxResults of zero pages through every result.
+func (c *Client) SearchAllSeries(ctx context.Context, name string, maxResults int, opts ...RequestOption) *Pager[Series] {
+	return searchPager(ctx, maxResults, func(ctx context.Context, limit, offset int) ([]Series, int, error) {
+		result, err := c.SearchSeries(ctx, name, limit, withOffset(opts, offset)...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.Series, result.Count, nil
+	})
+}
+
+// GetSeriesByID retrieves a series by its ID
+func (c *Client) GetSeriesByID(ctx context.Context, id MBID, opts ...RequestOption) (*Series, error) {
+	var series Series
+	if err := c.lookup(ctx, "series", id, opts, &series); err != nil {
+		return nil, err
+	}
+	series.RequestedID = id
+
+	return &series, nil
+}
@@ -0,0 +1,125 @@
+package musicbrainz
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+var unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// unknownFields returns the paths of the fields of the JSON document data
+// that are dropped when decoding it into v, sorted and without duplicates
+func unknownFields(data []byte, v any) []string {
+	if capture, ok := v.(*rawCapture); ok {
+		v = capture.v
+	}
+	seen := make(map[string]bool)
+	collectUnknownFields(data, reflect.TypeOf(v), "", seen)
+	fields := make([]string, 0, len(seen))
+	for field := range seen {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// collectUnknownFields walks data along the type t it was decoded into,
+// recording the paths of object keys that t has no field for
+func collectUnknownFields(data []byte, t reflect.Type, path string, seen map[string]bool) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	// Types decoding themselves, such as PartialDate, are taken as a whole
+	if t.Implements(unmarshalerType) || reflect.PointerTo(t).Implements(unmarshalerType) {
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		var object map[string]json.RawMessage
+		if json.Unmarshal(data, &object) != nil {
+			return
+		}
+		fields := jsonFields(t)
+		for key, value := range object {
+			fieldPath := key
+			if path != "" {
+				fieldPath = path + "." + key
+			}
+			field, ok := fields[key]
+			if !ok {
+				field, ok = foldField(fields, key)
+			}
+			if !ok {
+				seen[fieldPath] = true
+				continue
+			}
+			collectUnknownFields(value, field, fieldPath, seen)
+		}
+	case reflect.Slice, reflect.Array:
+		var elements []json.RawMessage
+		if json.Unmarshal(data, &elements) != nil {
+			return
+		}
+		for _, element := range elements {
+			collectUnknownFields(element, t.Elem(), path+"[]", seen)
+		}
+	case reflect.Map:
+		var object map[string]json.RawMessage
+		if json.Unmarshal(data, &object) != nil {
+			return
+		}
+		for _, value := range object {
+			collectUnknownFields(value, t.Elem(), path+".*", seen)
+		}
+	}
+}
+
+// jsonFields maps the JSON keys decoded by the struct type t to the types of
+// their fields, including the promoted fields of embedded structs
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := make(map[string]reflect.Type)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				for key, fieldType := range jsonFields(embedded) {
+					if _, ok := fields[key]; !ok {
+						fields[key] = fieldType
+					}
+				}
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = field.Type
+	}
+	return fields
+}
+
+// foldField finds the field matching key case-insensitively, as
+// encoding/json does
+func foldField(fields map[string]reflect.Type, key string) (reflect.Type, bool) {
+	for name, fieldType := range fields {
+		if strings.EqualFold(name, key) {
+			return fieldType, true
+		}
+	}
+	return nil, false
+}
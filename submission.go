@@ -0,0 +1,125 @@
+package musicbrainz
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+)
+
+// submissionNamespace is the XML namespace of submissions
+const submissionNamespace = "http://musicbrainz.org/ns/mmd-2.0#"
+
+// submission builds the XML document posted to the submission endpoints,
+// grouping the submitted data by entity type and entity
+type submission struct {
+	metadata xmlMetadata
+	lists    map[string]int
+	entities map[string]*xmlEntity
+}
+
+// xmlMetadata is the root element of a submission. Its namespace is set as
+// a plain attribute, so that it applies to the unqualified child elements.
+type xmlMetadata struct {
+	XMLName   xml.Name `xml:"metadata"`
+	Namespace string   `xml:"xmlns,attr"`
+	Lists     []*xmlEntityList
+}
+
+// xmlEntityList is a list of entities of a type, such as <artist-list>
+type xmlEntityList struct {
+	XMLName  xml.Name
+	Entities []*xmlEntity
+}
+
+// xmlEntity is an entity and the data submitted for it, such as <artist>
+type xmlEntity struct {
+	XMLName    xml.Name
+	ID         MBID            `xml:"id,attr"`
+	UserTags   *xmlUserTagList `xml:"user-tag-list"`
+	UserRating *int            `xml:"user-rating"`
+	ISRCs      *xmlISRCList    `xml:"isrc-list"`
+	Barcode    string          `xml:"barcode,omitempty"`
+}
+
+type xmlISRCList struct {
+	Count int       `xml:"count,attr"`
+	ISRCs []xmlISRC `xml:"isrc"`
+}
+
+type xmlISRC struct {
+	ID string `xml:"id,attr"`
+}
+
+type xmlUserTagList struct {
+	Tags []xmlUserTag `xml:"user-tag"`
+}
+
+type xmlUserTag struct {
+	Vote TagVote `xml:"vote,attr,omitempty"`
+	Name string  `xml:"name"`
+}
+
+// entity returns the element of the entity of type entityType with the given
+// MBID, adding it to the document if needed. allowed lists the entity types
+// the endpoint accepts.
+func (s *submission) entity(entityType string, id MBID, allowed []string) (*xmlEntity, error) {
+	if !containsString(allowed, entityType) {
+		return nil, fmt.Errorf("musicbrainz: can't submit to entity type %q", entityType)
+	}
+	if err := id.validate(); err != nil {
+		return nil, err
+	}
+	if s.lists == nil {
+		s.lists = make(map[string]int)
+		s.entities = make(map[string]*xmlEntity)
+	}
+
+	key := entityType + "/" + string(id)
+	if entity, ok := s.entities[key]; ok {
+		return entity, nil
+	}
+	index, ok := s.lists[entityType]
+	if !ok {
+		index = len(s.metadata.Lists)
+		s.lists[entityType] = index
+		s.metadata.Lists = append(s.metadata.Lists, &xmlEntityList{XMLName: xml.Name{Local: entityType + "-list"}})
+	}
+	entity := &xmlEntity{XMLName: xml.Name{Local: entityType}, ID: id}
+	list := s.metadata.Lists[index]
+	list.Entities = append(list.Entities, entity)
+	s.entities[key] = entity
+	return entity, nil
+}
+
+// empty reports whether nothing was added to the document
+func (s *submission) empty() bool {
+	return len(s.entities) == 0
+}
+
+// encode returns the XML document
+func (s *submission) encode() ([]byte, error) {
+	s.metadata.Namespace = submissionNamespace
+	return encodeXML(&s.metadata)
+}
+
+// submit posts the submission document to path, unless it is empty
+func (c *Client) submit(ctx context.Context, path string, s *submission) error {
+	if s.empty() {
+		return nil
+	}
+	body, err := s.encode()
+	if err != nil {
+		return err
+	}
+	return c.send(ctx, http.MethodPost, path, nil, body)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
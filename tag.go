@@ -0,0 +1,58 @@
+package musicbrainz
+
+import (
+	"context"
+	"fmt"
+)
+
+// TagVote is a vote of the authenticated user on a tag
+type TagVote string
+
+// Tag votes
+const (
+	TagUpvote   TagVote = "upvote"
+	TagDownvote TagVote = "downvote"
+	TagWithdraw TagVote = "withdraw"
+)
+
+// TagSubmission is a vote of the authenticated user on a tag of an entity
+type TagSubmission struct {
+	// EntityType is the type of the tagged entity, such as "artist" or
+	// "recording"
+	EntityType string
+	ID         MBID
+	Tag        string
+	Vote       TagVote
+}
+
+// taggableEntityTypes lists the types of entities accepting tags
+var taggableEntityTypes = []string{
+	"area", "artist", "event", "instrument", "label", "place", "recording",
+	"release", "release-group", "series", "work",
+}
+
+// SubmitTags submits the votes of the authenticated user on tags of
+// entities, adding tags that are upvoted for the first time. The votes are
+// sent in a single request.
+func (c *Client) SubmitTags(ctx context.Context, submissions []TagSubmission) error {
+	var s submission
+	for _, submission := range submissions {
+		switch submission.Vote {
+		case TagUpvote, TagDownvote, TagWithdraw:
+		default:
+			return fmt.Errorf("musicbrainz: invalid tag vote %q", submission.Vote)
+		}
+		if submission.Tag == "" {
+			return fmt.Errorf("musicbrainz: empty tag for %s %s", submission.EntityType, submission.ID)
+		}
+		entity, err := s.entity(submission.EntityType, submission.ID, taggableEntityTypes)
+		if err != nil {
+			return err
+		}
+		if entity.UserTags == nil {
+			entity.UserTags = &xmlUserTagList{}
+		}
+		entity.UserTags.Tags = append(entity.UserTags.Tags, xmlUserTag{Vote: submission.Vote, Name: submission.Tag})
+	}
+	return c.submit(ctx, "tag", &s)
+}
@@ -0,0 +1,140 @@
+package musicbrainz
+
+import (
+	"context"
+	"sort"
+	"strings"
+)
+
+// WeightedGenre is a genre or tag ranked by GetTopGenres
+type WeightedGenre struct {
+	// Name is lowercased
+	Name string
+	// Weight ranges from 0 to 1, 1 being the most voted genre of the
+	// recording, its release group and its artists at once
+	Weight float64
+	// Genre reports whether the name is on the official genre list rather
+	// than a free-form tag
+	Genre bool
+}
+
+// Weights of the entities whose genres GetTopGenres merges
+const (
+	recordingGenreWeight    = 0.5
+	releaseGroupGenreWeight = 0.3
+	artistGenreWeight       = 0.2
+)
+
+// GetTopGenres retrieves the genres and tags of a recording, of the release
+// group of its canonical release and of its artists, and merges them into a
+// single list sorted by decreasing weight. The votes for each entity are
+// scaled to its most voted genre, so that popular artists don't drown out
+// the recording, and the recording weighs more than its release group, which
+// weighs more than its artists. This takes a request for the recording, its
+// release group and each of its artists.
+func (c *Client) GetTopGenres(ctx context.Context, recordingID MBID) ([]WeightedGenre, error) {
+	recording, err := c.GetRecordingByID(ctx, recordingID, WithIncludes("genres", "tags", "artist-credits", "releases", "release-groups"))
+	if err != nil {
+		return nil, err
+	}
+
+	var releaseGroupID MBID
+	if len(recording.Releases) > 0 {
+		releaseGroupID = (&ReleasePicker{}).Pick(recording.Releases).ReleaseGroup.ID
+	}
+	return c.topGenres(ctx, recording, releaseGroupID)
+}
+
+// topGenres merges the genres and tags of recording, looked up with its
+// genres, tags and artist credits, with those of the given release group,
+// if any, and of its artists
+func (c *Client) topGenres(ctx context.Context, recording *Recording, releaseGroupID MBID) ([]WeightedGenre, error) {
+	var w genreWeights
+	w.add(recording.Genres, recording.Tags, recordingGenreWeight)
+
+	if releaseGroupID != "" {
+		releaseGroup, err := c.GetReleaseGroupByID(ctx, releaseGroupID, WithIncludes("genres", "tags"))
+		if err != nil {
+			return nil, err
+		}
+		w.add(releaseGroup.Genres, releaseGroup.Tags, releaseGroupGenreWeight)
+	}
+
+	artists := recording.ArtistCredit.IDs()
+	for _, id := range artists {
+		artist, err := c.GetArtistByID(ctx, id, WithIncludes("genres", "tags"))
+		if err != nil {
+			return nil, err
+		}
+		w.add(artist.Genres, artist.Tags, artistGenreWeight/float64(len(artists)))
+	}
+	return w.sorted(), nil
+}
+
+// genreWeights accumulates the weights of genres and tags by name
+type genreWeights struct {
+	weights map[string]*WeightedGenre
+}
+
+// add merges the genres and tags of an entity, giving its most voted one
+// the given weight
+func (w *genreWeights) add(genres []Genre, tags []Tag, weight float64) {
+	counts := make(map[string]int)
+	isGenre := make(map[string]bool)
+	for _, genre := range genres {
+		name := strings.ToLower(genre.Name)
+		isGenre[name] = true
+		if genre.Count > counts[name] {
+			counts[name] = genre.Count
+		}
+	}
+	// Genres are also listed as tags, so the votes are merged rather than
+	// added up
+	for _, tag := range tags {
+		name := strings.ToLower(tag.Name)
+		if tag.Count > counts[name] {
+			counts[name] = tag.Count
+		}
+	}
+
+	top := 0
+	for _, count := range counts {
+		if count > top {
+			top = count
+		}
+	}
+	if top <= 0 {
+		return
+	}
+
+	if w.weights == nil {
+		w.weights = make(map[string]*WeightedGenre)
+	}
+	for name, count := range counts {
+		if count <= 0 {
+			continue
+		}
+		genre := w.weights[name]
+		if genre == nil {
+			genre = &WeightedGenre{Name: name}
+			w.weights[name] = genre
+		}
+		genre.Weight += weight * float64(count) / float64(top)
+		genre.Genre = genre.Genre || isGenre[name]
+	}
+}
+
+// sorted returns the accumulated genres by decreasing weight, then name
+func (w *genreWeights) sorted() []WeightedGenre {
+	genres := make([]WeightedGenre, 0, len(w.weights))
+	for _, genre := range w.weights {
+		genres = append(genres, *genre)
+	}
+	sort.Slice(genres, func(i, j int) bool {
+		if genres[i].Weight != genres[j].Weight {
+			return genres[i].Weight > genres[j].Weight
+		}
+		return genres[i].Name < genres[j].Name
+	})
+	return genres
+}
@@ -0,0 +1,78 @@
+package musicbrainz
+
+import "context"
+
+// TracklistTrack is a track of a release as listed by GetReleaseTracklist
+type TracklistTrack struct {
+	// DiscNumber is the position of the medium of the track on the release,
+	// starting at 1
+	DiscNumber int
+	// DiscTitle is the title of the medium, which is usually empty
+	DiscTitle string
+	// Format is the format of the medium, such as "CD"
+	Format string
+	// TrackNumber is the position of the track on its medium, starting at 1,
+	// or 0 for a hidden pregap track
+	TrackNumber int
+	// Number is the track number as printed on the release, such as "A1"
+	Number       string
+	Title        string
+	ArtistCredit ArtistCredits
+	Length       Duration
+	TrackID      MBID
+	RecordingID  MBID
+}
+
+// Artist returns the artist credit of the track as a single string
+func (t *TracklistTrack) Artist() string {
+	return t.ArtistCredit.String()
+}
+
+// GetReleaseTracklist retrieves the tracks of a release in order, across
+// all of its media, including pregap and data tracks. The artist credit of
+// each track falls back to the credit of the release when the track has
+// none.
+func (c *Client) GetReleaseTracklist(ctx context.Context, releaseID MBID, opts ...RequestOption) ([]TracklistTrack, error) {
+	opts = append([]RequestOption{WithIncludes("recordings", "artist-credits")}, opts...)
+	release, err := c.GetReleaseByID(ctx, releaseID, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var tracklist []TracklistTrack
+	for i := range release.Media {
+		medium := &release.Media[i]
+		// The media may be shared with concurrent lookups of the release, so
+		// the tracks are gathered into a slice of their own
+		tracks := make([]Track, 0, len(medium.Tracks)+len(medium.DataTracks)+1)
+		if medium.Pregap != nil {
+			tracks = append(tracks, *medium.Pregap)
+		}
+		tracks = append(tracks, medium.Tracks...)
+		tracks = append(tracks, medium.DataTracks...)
+		for j := range tracks {
+			track := &tracks[j]
+			credit := track.ArtistCredit
+			if len(credit) == 0 {
+				credit = release.ArtistCredit
+			}
+			length := track.Length
+			if length == 0 {
+				length = track.Recording.Length
+			}
+			tracklist = append(tracklist, TracklistTrack{
+				DiscNumber:   medium.Position,
+				DiscTitle:    medium.Title,
+				Format:       medium.Format,
+				TrackNumber:  track.Position,
+				Number:       track.Number,
+				Title:        track.Title,
+				ArtistCredit: credit,
+				Length:       length,
+				TrackID:      track.ID,
+				RecordingID:  track.Recording.ID,
+			})
+		}
+	}
+	return tracklist, nil
+}
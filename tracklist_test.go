@@ -0,0 +1,69 @@
+package musicbrainz
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// tracklistRelease has three tracks, which decode into a slice with spare
+// capacity, and a data track
+const tracklistRelease = `{
+	"id": "b84ee12a-09ef-421b-82de-0441a926375b",
+	"title": "Nevermind",
+	"artist-credit": [{"name": "Nirvana", "artist": {"id": "5b11f4ce-a62d-471e-81fc-a69a8278c7da", "name": "Nirvana"}}],
+	"media": [{
+		"position": 1,
+		"format": "Enhanced CD",
+		"track-count": 3,
+		"tracks": [
+			{"id": "6b8a6d0a-8ee9-3e7f-b6f6-0a0d3a4f5a11", "position": 1, "number": "1", "title": "Smells Like Teen Spirit"},
+			{"id": "7c9b7e1b-9ff0-4f80-c7f7-1b1e4b5a6b22", "position": 2, "number": "2", "title": "In Bloom"},
+			{"id": "8dac8f2c-a001-4f91-d808-2c2f5c6b7c33", "position": 3, "number": "3", "title": "Come as You Are"}
+		],
+		"data-tracks": [
+			{"id": "9ebd903d-b112-4aa2-e919-3d306d7c8d44", "position": 4, "number": "4", "title": "Video"}
+		]
+	}]
+}`
+
+func TestConcurrentTracklists(t *testing.T) {
+	release := make(chan struct{})
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		<-release
+		w.Write([]byte(tracklistRelease))
+	}))
+	defer server.Close()
+	c, err := NewClient(WithBaseURL(server.URL), WithoutRateLimit(), WithRetry(1, 0, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The lookups are coalesced, so that the callers share the media of the
+	// release
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tracklist, err := c.GetReleaseTracklist(context.Background(), "b84ee12a-09ef-421b-82de-0441a926375b")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if len(tracklist) != 4 || tracklist[0].Title != "Smells Like Teen Spirit" || tracklist[3].Title != "Video" {
+				t.Errorf("got tracklist %+v", tracklist)
+			}
+		}()
+	}
+	waitRequests(t, &requests, 1)
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+}
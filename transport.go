@@ -0,0 +1,63 @@
+package musicbrainz
+
+import (
+	"crypto/tls"
+	"errors"
+	"net/http"
+	"net/url"
+)
+
+// defaultMaxIdleConnsPerHost is the number of idle connections a Client
+// keeps to each host. Every request goes to one of a couple of hosts, so it
+// is above the default of net/http, which is meant for clients talking to
+// many hosts.
+const defaultMaxIdleConnsPerHost = 8
+
+// newTransport returns the transport of a new Client. Each Client keeps its
+// own transport, reusing connections across all of its requests.
+func newTransport() *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConnsPerHost = defaultMaxIdleConnsPerHost
+	return transport
+}
+
+// transportOption returns an Option changing the transport of the Client.
+// Such options can't be combined with WithHTTPClient.
+func transportOption(configure func(transport *http.Transport) error) Option {
+	return func(c *Client) error {
+		c.transportConfigured = true
+		return configure(c.transport)
+	}
+}
+
+// WithMaxIdleConnsPerHost sets the number of idle connections kept open to
+// each host for reuse by later requests
+func WithMaxIdleConnsPerHost(n int) Option {
+	return transportOption(func(transport *http.Transport) error {
+		if n < 0 {
+			return errors.New("musicbrainz: idle connections must not be negative")
+		}
+		transport.MaxIdleConnsPerHost = n
+		return nil
+	})
+}
+
+// WithTLSConfig sets the TLS configuration of connections, for example to
+// trust the certificate of a private mirror
+func WithTLSConfig(config *tls.Config) Option {
+	return transportOption(func(transport *http.Transport) error {
+		transport.TLSClientConfig = config
+		return nil
+	})
+}
+
+// WithProxy sets the function selecting the proxy of each request, such as
+// http.ProxyURL. By default, the proxy is taken from the HTTP_PROXY,
+// HTTPS_PROXY and NO_PROXY environment variables. A nil proxy disables
+// proxying.
+func WithProxy(proxy func(*http.Request) (*url.URL, error)) Option {
+	return transportOption(func(transport *http.Transport) error {
+		transport.Proxy = proxy
+		return nil
+	})
+}
@@ -0,0 +1,107 @@
+package musicbrainz
+
+// ArtistType is the type of an artist, such as ArtistTypePerson
+type ArtistType string
+
+// Artist types
+const (
+	ArtistTypePerson    ArtistType = "Person"
+	ArtistTypeGroup     ArtistType = "Group"
+	ArtistTypeOrchestra ArtistType = "Orchestra"
+	ArtistTypeChoir     ArtistType = "Choir"
+	ArtistTypeCharacter ArtistType = "Character"
+	ArtistTypeOther     ArtistType = "Other"
+)
+
+// IsPerson reports whether the artist is an individual
+func (t ArtistType) IsPerson() bool {
+	return t == ArtistTypePerson
+}
+
+// IsGroup reports whether the artist is made of several people, including
+// orchestras and choirs
+func (t ArtistType) IsGroup() bool {
+	return t == ArtistTypeGroup || t == ArtistTypeOrchestra || t == ArtistTypeChoir
+}
+
+// ReleaseStatus is the status of a release, such as ReleaseStatusOfficial
+type ReleaseStatus string
+
+// Release statuses
+const (
+	ReleaseStatusOfficial      ReleaseStatus = "Official"
+	ReleaseStatusPromotion     ReleaseStatus = "Promotion"
+	ReleaseStatusBootleg       ReleaseStatus = "Bootleg"
+	ReleaseStatusPseudoRelease ReleaseStatus = "Pseudo-Release"
+	ReleaseStatusWithdrawn     ReleaseStatus = "Withdrawn"
+	ReleaseStatusExpunged      ReleaseStatus = "Expunged"
+	ReleaseStatusCancelled     ReleaseStatus = "Cancelled"
+)
+
+// IsOfficial reports whether the release was officially sanctioned by the
+// artist or their label
+func (s ReleaseStatus) IsOfficial() bool {
+	return s == ReleaseStatusOfficial
+}
+
+// IsBootleg reports whether the release is unofficial
+func (s ReleaseStatus) IsBootleg() bool {
+	return s == ReleaseStatusBootleg
+}
+
+// ReleaseGroupType is the primary type of a release group, such as
+// ReleaseGroupTypeAlbum
+type ReleaseGroupType string
+
+// Release group primary types
+const (
+	ReleaseGroupTypeAlbum     ReleaseGroupType = "Album"
+	ReleaseGroupTypeSingle    ReleaseGroupType = "Single"
+	ReleaseGroupTypeEP        ReleaseGroupType = "EP"
+	ReleaseGroupTypeBroadcast ReleaseGroupType = "Broadcast"
+	ReleaseGroupTypeOther     ReleaseGroupType = "Other"
+)
+
+// ReleaseGroupSecondaryType is a secondary type of a release group, such as
+// ReleaseGroupSecondaryTypeLive
+type ReleaseGroupSecondaryType string
+
+// Release group secondary types
+const (
+	ReleaseGroupSecondaryTypeCompilation    ReleaseGroupSecondaryType = "Compilation"
+	ReleaseGroupSecondaryTypeSoundtrack     ReleaseGroupSecondaryType = "Soundtrack"
+	ReleaseGroupSecondaryTypeSpokenword     ReleaseGroupSecondaryType = "Spokenword"
+	ReleaseGroupSecondaryTypeInterview      ReleaseGroupSecondaryType = "Interview"
+	ReleaseGroupSecondaryTypeAudiobook      ReleaseGroupSecondaryType = "Audiobook"
+	ReleaseGroupSecondaryTypeAudioDrama     ReleaseGroupSecondaryType = "Audio drama"
+	ReleaseGroupSecondaryTypeLive           ReleaseGroupSecondaryType = "Live"
+	ReleaseGroupSecondaryTypeRemix          ReleaseGroupSecondaryType = "Remix"
+	ReleaseGroupSecondaryTypeDJMix          ReleaseGroupSecondaryType = "DJ-mix"
+	ReleaseGroupSecondaryTypeMixtape        ReleaseGroupSecondaryType = "Mixtape/Street"
+	ReleaseGroupSecondaryTypeDemo           ReleaseGroupSecondaryType = "Demo"
+	ReleaseGroupSecondaryTypeFieldRecording ReleaseGroupSecondaryType = "Field recording"
+)
+
+// HasSecondaryType reports whether the release group has the given
+// secondary type
+func (rg *ReleaseGroup) HasSecondaryType(t ReleaseGroupSecondaryType) bool {
+	for _, secondaryType := range rg.SecondaryTypes {
+		if secondaryType == t {
+			return true
+		}
+	}
+	return false
+}
+
+// IsStudioAlbum reports whether the release group is an album without
+// secondary types, excluding compilations, live albums, soundtracks and the
+// like
+func (rg *ReleaseGroup) IsStudioAlbum() bool {
+	return rg.PrimaryType == ReleaseGroupTypeAlbum && len(rg.SecondaryTypes) == 0
+}
+
+// IsOfficial reports whether the release was officially sanctioned by the
+// artist or their label
+func (r *Release) IsOfficial() bool {
+	return r.Status.IsOfficial()
+}
@@ -0,0 +1,51 @@
+package musicbrainz
+
+import (
+	"context"
+	"net/url"
+)
+
+// URL represents a URL in the MusicBrainz database, such as a Wikipedia page
+// or a streaming service link, along with the entities it is related to
+type URL struct {
+	ID          MBID       `json:"id"`
+	RequestedID MBID       `json:"-"`
+	Resource    string     `json:"resource"`
+	Relations   []Relation `json:"relations"`
+}
+
+// GetURLByID retrieves a URL and its relationships by its ID
+func (c *Client) GetURLByID(ctx context.Context, id MBID, opts ...RequestOption) (*URL, error) {
+	var u URL
+	if err := c.lookup(ctx, "url", id, withAllRelations(opts), &u); err != nil {
+		return nil, err
+	}
+	u.RequestedID = id
+
+	return &u, nil
+}
+
+// LookupURLByResource retrieves a URL and its relationships by the URL
+// itself, such as "https://open.spotify.com/artist/...", which maps an
+// external link back to MusicBrainz entities
+func (c *Client) LookupURLByResource(ctx context.Context, resource string, opts ...RequestOption) (*URL, error) {
+	o := collectOptions(withAllRelations(opts))
+	params := url.Values{}
+	params.Set("resource", resource)
+	if err := o.setIncludes(params, "url"); err != nil {
+		return nil, err
+	}
+
+	var u URL
+	if err := c.get(ctx, "url", params, o.target(&u)); err != nil {
+		return nil, err
+	}
+
+	return &u, nil
+}
+
+// withAllRelations prepends an option requesting every kind of relationship
+// to opts
+func withAllRelations(opts []RequestOption) []RequestOption {
+	return append([]RequestOption{WithIncludes(relationIncludes...)}, opts...)
+}
@@ -0,0 +1,73 @@
+package musicbrainz
+
+import "context"
+
+// RecordingVersion is another version of a recording, found by
+// GetRecordingVersions
+type RecordingVersion struct {
+	Recording Recording
+	// Work is the work both recordings perform, or nil for a remix found
+	// through a direct relationship between the recordings
+	Work *Work
+	// Attributes are those of the performance relationship, such as "cover",
+	// "live", "partial" or "instrumental"
+	Attributes []string
+	Cover      bool
+	Live       bool
+	// Remix is set for remixes of the recording, never for the recording
+	// it is itself a remix of
+	Remix bool
+}
+
+// GetRecordingVersions retrieves the other recordings of the works a
+// recording performs, such as covers and live versions, along with its
+// remixes. The recording itself is left out. This takes one request for the
+// recording and one per work.
+func (c *Client) GetRecordingVersions(ctx context.Context, recordingID MBID) ([]RecordingVersion, error) {
+	recording, err := c.GetRecordingByID(ctx, recordingID, WithIncludes("work-rels", "recording-rels"))
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[MBID]bool{recording.ID: true}
+	var versions []RecordingVersion
+	for i := range recording.Relations {
+		relation := &recording.Relations[i]
+		// Forward remix relationships point from a remix to its original
+		if relation.Type != "remix" || !relation.Backward() || relation.Recording == nil || seen[relation.Recording.ID] {
+			continue
+		}
+		seen[relation.Recording.ID] = true
+		versions = append(versions, RecordingVersion{
+			Recording:  *relation.Recording,
+			Attributes: relation.Attributes,
+			Remix:      true,
+		})
+	}
+
+	for i := range recording.Relations {
+		relation := &recording.Relations[i]
+		if relation.Type != "performance" || relation.Work == nil {
+			continue
+		}
+		work, err := c.GetWorkByID(ctx, relation.Work.ID, WithIncludes("recording-rels"))
+		if err != nil {
+			return nil, err
+		}
+		for j := range work.Relations {
+			performance := &work.Relations[j]
+			if performance.Type != "performance" || performance.Recording == nil || seen[performance.Recording.ID] {
+				continue
+			}
+			seen[performance.Recording.ID] = true
+			versions = append(versions, RecordingVersion{
+				Recording:  *performance.Recording,
+				Work:       relation.Work,
+				Attributes: performance.Attributes,
+				Cover:      performance.HasAttribute("cover"),
+				Live:       performance.HasAttribute("live"),
+			})
+		}
+	}
+	return versions, nil
+}
@@ -0,0 +1,135 @@
+package musicbrainz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// WikidataEndpoint is the base URL of the Wikidata entity data endpoint
+const WikidataEndpoint = "https://www.wikidata.org/wiki/Special:EntityData/"
+
+// commonsFilePath is the URL prefix redirecting to a Wikimedia Commons file
+const commonsFilePath = "https://commons.wikimedia.org/wiki/Special:FilePath/"
+
+// wikidataQID matches the item ID at the end of a Wikidata URL
+var wikidataQID = regexp.MustCompile(`/(Q[0-9]+)$`)
+
+// WikidataInfo is what Wikidata knows of an artist beyond MusicBrainz
+type WikidataInfo struct {
+	// QID is the Wikidata item ID, such as "Q1299"
+	QID string
+	// Label and Description are in English, or empty if Wikidata has no
+	// English text. Descriptions holds the descriptions by language code.
+	Label        string
+	Description  string
+	Descriptions map[string]string
+	// ImageURL links to the image of the item on Wikimedia Commons, usually
+	// a photo of the artist, or is empty if the item has none
+	ImageURL string
+	// WikipediaURL links to the English Wikipedia article, if any
+	WikipediaURL string
+}
+
+// EnrichArtistFromWikidata retrieves the description and image of an artist
+// from Wikidata, which MusicBrainz doesn't store. The Wikidata item is found
+// through the URL relationships of the artist, which are requested if the
+// artist has no relationships. It returns ErrNotFound if the artist isn't
+// linked to Wikidata. Wikidata requests bypass the MusicBrainz rate limit.
+func (c *Client) EnrichArtistFromWikidata(ctx context.Context, artist *Artist) (*WikidataInfo, error) {
+	qid := wikidataItem(artist.Relations)
+	if qid == "" && len(artist.Relations) == 0 {
+		withRelations, err := c.GetArtistByID(ctx, artist.ID, WithIncludes("url-rels"))
+		if err != nil {
+			return nil, err
+		}
+		qid = wikidataItem(withRelations.Relations)
+	}
+	if qid == "" {
+		return nil, fmt.Errorf("%w: no Wikidata item for artist %s", ErrNotFound, artist.ID)
+	}
+
+	var data wikidataDocument
+	if err := c.getJSON(ctx, nil, c.wikidataURL+qid+".json", &data); err != nil {
+		return nil, err
+	}
+	entity, ok := data.Entities[qid]
+	if !ok {
+		// Items merged on Wikidata are served under the ID they were merged
+		// into
+		for id, e := range data.Entities {
+			qid, entity = id, e
+		}
+	}
+
+	info := &WikidataInfo{
+		QID:          qid,
+		Label:        entity.Labels["en"].Value,
+		Description:  entity.Descriptions["en"].Value,
+		Descriptions: make(map[string]string, len(entity.Descriptions)),
+	}
+	for language, text := range entity.Descriptions {
+		info.Descriptions[language] = text.Value
+	}
+	// P18 is the "image" property
+	for _, claim := range entity.Claims["P18"] {
+		if file, ok := claim.MainSnak.DataValue.Value.(string); ok && file != "" {
+			info.ImageURL = commonsFilePath + url.PathEscape(strings.ReplaceAll(file, " ", "_"))
+			break
+		}
+	}
+	if link, ok := entity.Sitelinks["enwiki"]; ok {
+		info.WikipediaURL = "https://en.wikipedia.org/wiki/" + url.PathEscape(strings.ReplaceAll(link.Title, " ", "_"))
+	}
+	return info, nil
+}
+
+// wikidataDocument is the part of a Wikidata entity data document
+// EnrichArtistFromWikidata reads
+type wikidataDocument struct {
+	Entities map[string]wikidataEntity `json:"entities"`
+}
+
+// UnmarshalJSON decodes the document. Wikidata documents hold far more than
+// is modeled here, so implementing json.Unmarshaler keeps them out of the
+// checks of WithStrictDecoding.
+func (d *wikidataDocument) UnmarshalJSON(data []byte) error {
+	type document wikidataDocument
+	return json.Unmarshal(data, (*document)(d))
+}
+
+type wikidataEntity struct {
+	Labels       map[string]wikidataText `json:"labels"`
+	Descriptions map[string]wikidataText `json:"descriptions"`
+	Claims       map[string][]struct {
+		MainSnak struct {
+			DataValue struct {
+				Value any `json:"value"`
+			} `json:"datavalue"`
+		} `json:"mainsnak"`
+	} `json:"claims"`
+	Sitelinks map[string]struct {
+		Title string `json:"title"`
+	} `json:"sitelinks"`
+}
+
+type wikidataText struct {
+	Value string `json:"value"`
+}
+
+// wikidataItem returns the Wikidata item ID linked by relations, or an empty
+// string if there is none
+func wikidataItem(relations []Relation) string {
+	for _, relation := range relations {
+		if relation.Type != "wikidata" || relation.URL == nil {
+			continue
+		}
+		if match := wikidataQID.FindStringSubmatch(relation.URL.Resource); match != nil {
+			return match[1]
+		}
+	}
+	return ""
+}
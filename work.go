@@ -0,0 +1,106 @@
+package musicbrainz
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+// Work represents a work in the MusicBrainz database: a distinct
+// intellectual or artistic creation such as a song or a symphony
+type Work struct {
+	ID          MBID            `json:"id"`
+	RequestedID MBID            `json:"-"`
+	Title       string          `json:"title"`
+	Type        string          `json:"type"`
+	Language    string          `json:"language"`
+	Languages   []string        `json:"languages"`
+	ISWCs       []string        `json:"iswcs"`
+	Attributes  []WorkAttribute `json:"attributes"`
+	Disambig    string          `json:"disambiguation"`
+	Relations   []Relation      `json:"relations"`
+	Tags        []Tag           `json:"tags"`
+	Genres      []Genre         `json:"genres"`
+	UserTags    []Tag           `json:"user-tags"`
+	UserGenres  []Genre         `json:"user-genres"`
+	Rating      *Rating         `json:"rating"`
+	UserRating  *Rating         `json:"user-rating"`
+	Score       int             `json:"score"`
+}
+
+// WorkAttribute represents an attribute of a work, such as its key
+type WorkAttribute struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// Attribute returns the value of the first attribute of the given type, such
+// as "Key", or an empty string if the work has no such attribute
+func (w *Work) Attribute(attributeType string) string {
+	for _, attribute := range w.Attributes {
+		if attribute.Type == attributeType {
+			return attribute.Value
+		}
+	}
+	return ""
+}
+
+// WorkSearchResult is a page of works returned by a search
+type WorkSearchResult struct {
+	Count   int       `json:"count"`
+	Offset  int       `json:"offset"`
+	Created time.Time `json:"created"`
+	Works   []Work    `json:"works"`
+}
+
+// SearchWorks searches for works by their title. Use WithOffset to page through
+// the results.
+func (c *Client) SearchWorks(ctx context.Context, title string, limit int, opts ...RequestOption) (*WorkSearchResult, error) {
+	var result WorkSearchResult
+	if err := c.search(ctx, "work", title, limit, opts, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// SearchAllWorks returns a Pager over the works matching a search, stopping
+// after maxResults results. A maxResults of zero pages through every result.
+func (c *Client) SearchAllWorks(ctx context.Context, title string, maxResults int, opts ...RequestOption) *Pager[Work] {
+	return searchPager(ctx, maxResults, func(ctx context.Context, limit, offset int) ([]Work, int, error) {
+		result, err := c.SearchWorks(ctx, title, limit, withOffset(opts, offset)...)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.Works, result.Count, nil
+	})
+}
+
+// GetWorkByID retrieves a work by its ID
+func (c *Client) GetWorkByID(ctx context.Context, id MBID, opts ...RequestOption) (*Work, error) {
+	var work Work
+	if err := c.lookup(ctx, "work", id, opts, &work); err != nil {
+		return nil, err
+	}
+	work.RequestedID = id
+
+	return &work, nil
+}
+
+// GetWorksByISWC retrieves the works bound to an ISWC, such as "T-345246800-1"
+func (c *Client) GetWorksByISWC(ctx context.Context, iswc string, opts ...RequestOption) ([]Work, error) {
+	o := collectOptions(opts)
+	params := url.Values{}
+	if err := o.setIncludes(params, "work"); err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Works []Work `json:"works"`
+	}
+	if err := c.get(ctx, "iswc/"+url.PathEscape(iswc), params, o.target(&result)); err != nil {
+		return nil, err
+	}
+
+	return result.Works, nil
+}
@@ -0,0 +1,359 @@
+package musicbrainz
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Format is the wire format of the responses of the MusicBrainz API
+type Format string
+
+const (
+	// FormatJSON requests JSON responses, the default
+	FormatJSON Format = "json"
+	// FormatXML requests XML responses, following the MusicBrainz XML
+	// schema, decoded into the same types as JSON responses
+	FormatXML Format = "xml"
+)
+
+// WithFormat sets the format of the responses requested from the API.
+// Responses are decoded into the same types whatever their format. With
+// FormatXML, WithRawResponse captures the JSON equivalent of the XML
+// document rather than the document itself, and WithStrictDecoding can't be
+// used as the conversion only keeps the fields the types model.
+func WithFormat(format Format) Option {
+	return func(c *Client) error {
+		if format != FormatJSON && format != FormatXML {
+			return errors.New("musicbrainz: unknown format " + strconv.Quote(string(format)))
+		}
+		c.format = format
+		return nil
+	}
+}
+
+// isXML reports whether rawURL requests an XML response from the API
+func isXML(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	return err == nil && u.Query().Get("fmt") == string(FormatXML)
+}
+
+// encodeXML returns the XML document encoding v, with an XML declaration
+func encodeXML(v any) ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteString(xml.Header)
+	if err := xml.NewEncoder(&b).Encode(v); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// xmlToJSON converts an XML response of the API into the JSON document the
+// API returns for the same request, so that it decodes into v. The XML
+// schema names lists and attributes differently than JSON, so the
+// conversion is guided by the JSON field names of the type of v:
+//
+//   - a field is read from the attribute or child element of its name
+//   - a slice field such as "aliases" is read from the items of the
+//     "alias-list" element, or of the element of its own name such as
+//     "artist-credit"
+//   - count and offset fields such as "track-count" are read from the
+//     attributes of the matching list
+//   - name and value fields fall back to the text of the element, as for
+//     aliases and ratings
+func xmlToJSON(data []byte, v any) ([]byte, error) {
+	if capture, ok := v.(*rawCapture); ok {
+		v = capture.v
+	}
+	root, err := parseXML(data)
+	if err != nil {
+		return nil, err
+	}
+	// Lookups wrap the entity in the root element, where JSON returns it
+	// alone
+	if len(root.children) == 1 && !strings.HasSuffix(root.children[0].name, "-list") {
+		root = root.children[0]
+	}
+	return json.Marshal(root.convert(reflect.TypeOf(v).Elem()))
+}
+
+// xmlNode is an element of an XML document. Names are stripped of their
+// namespace, such as the ext:score attribute of search results.
+type xmlNode struct {
+	name     string
+	attrs    map[string]string
+	children []*xmlNode
+	text     string
+}
+
+// parseXML returns the root element of an XML document
+func parseXML(data []byte) (*xmlNode, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	var stack []*xmlNode
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch token := token.(type) {
+		case xml.StartElement:
+			node := &xmlNode{name: token.Name.Local, attrs: make(map[string]string, len(token.Attr))}
+			for _, attr := range token.Attr {
+				if attr.Name.Space != "xmlns" && attr.Name.Local != "xmlns" {
+					node.attrs[attr.Name.Local] = attr.Value
+				}
+			}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, node)
+			}
+			stack = append(stack, node)
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text += string(token)
+			}
+		case xml.EndElement:
+			node := stack[len(stack)-1]
+			node.text = strings.TrimSpace(node.text)
+			if len(stack) == 1 {
+				return node, nil
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+}
+
+// child returns the first child element with the given name
+func (n *xmlNode) child(name string) *xmlNode {
+	for _, child := range n.children {
+		if child.name == name {
+			return child
+		}
+	}
+	return nil
+}
+
+var (
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	rawMessageType      = reflect.TypeOf(json.RawMessage(nil))
+)
+
+// convert returns the JSON value of the element decoding into type t
+func (n *xmlNode) convert(t reflect.Type) any {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if reflect.PointerTo(t).Implements(jsonUnmarshalerType) && !hasJSONFields(t) {
+		// Types decoding themselves, such as dates and durations, are
+		// encoded as a JSON scalar
+		if n.text == "" {
+			return nil
+		}
+		return scalar(n.text, t)
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		object := make(map[string]any)
+		n.convertFields(t, object)
+		if reflect.PointerTo(t).Implements(jsonUnmarshalerType) {
+			// Browse responses decoding themselves find their count and
+			// offset in fields named after the browsed entities
+			for _, child := range n.children {
+				if entity, ok := strings.CutSuffix(child.name, "-list"); ok {
+					for _, key := range []string{"count", "offset"} {
+						if value, ok := child.attrs[key]; ok {
+							object[entity+"-"+key] = json.Number(value)
+						}
+					}
+				}
+			}
+		}
+		return object
+	case reflect.Slice, reflect.Map, reflect.Interface:
+		return nil
+	}
+	return scalar(n.text, t)
+}
+
+// convertFields adds the fields of struct type t read from the element to
+// object, including those of embedded structs
+func (n *xmlNode) convertFields(t reflect.Type, object map[string]any) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			n.convertFields(field.Type, object)
+			continue
+		}
+		if !field.IsExported() || name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if value, ok := n.field(name, field.Type); ok {
+			object[name] = value
+		}
+	}
+}
+
+// field returns the JSON value of the field with the given name and type
+func (n *xmlNode) field(name string, t reflect.Type) (any, bool) {
+	base := t
+	for base.Kind() == reflect.Pointer {
+		base = base.Elem()
+	}
+	if base.Kind() == reflect.Slice && base != rawMessageType {
+		items, ok := n.items(name)
+		if !ok {
+			return nil, false
+		}
+		values := make([]any, len(items))
+		for i, item := range items {
+			if item.text == "" && len(item.children) == 0 && item.attrs["id"] != "" {
+				// String lists such as ISRCs are lists of empty elements
+				// with an id attribute
+				item = &xmlNode{name: item.name, text: item.attrs["id"]}
+			}
+			values[i] = item.convert(base.Elem())
+		}
+		return values, true
+	}
+
+	if value, ok := n.attrs[name]; ok {
+		return scalar(value, base), true
+	}
+	if child := n.child(name); child != nil {
+		return child.convert(t), true
+	}
+
+	switch {
+	case name == "count" || name == "offset":
+		for _, child := range n.children {
+			if value, ok := child.attrs[name]; ok && strings.HasSuffix(child.name, "-list") {
+				return scalar(value, base), true
+			}
+		}
+	case strings.HasSuffix(name, "-count") || strings.HasSuffix(name, "-offset"):
+		i := strings.LastIndex(name, "-")
+		if list := n.child(name[:i] + "-list"); list != nil {
+			if value, ok := list.attrs[name[i+1:]]; ok {
+				return scalar(value, base), true
+			}
+		}
+	case name == "name" && n.name == "name-credit":
+		// Credits under the artist's own name only name the artist
+		if artist := n.child("artist"); artist != nil {
+			if artistName := artist.child("name"); artistName != nil {
+				return artistName.text, true
+			}
+		}
+	case name == "name" || name == "value":
+		if n.text != "" {
+			return scalar(n.text, base), true
+		}
+	case name == "direction" && n.name == "relation":
+		return "forward", true
+	case name == "url" && n.attrs["target-type"] == "url":
+		// URL relationships only hold the URL as their target
+		if target := n.child("target"); target != nil {
+			urlNode := &xmlNode{
+				name:     "url",
+				attrs:    map[string]string{"id": target.attrs["id"]},
+				children: []*xmlNode{{name: "resource", text: target.text}},
+			}
+			return urlNode.convert(t), true
+		}
+	}
+	return nil, false
+}
+
+// items returns the items of the list field with the given name, gathered
+// from every matching list element, as relationships are listed by target
+// type. Items inherit the attributes of their list, such as target-type.
+func (n *xmlNode) items(name string) ([]*xmlNode, bool) {
+	names := []string{name + "-list", name}
+	for _, singular := range singulars(name) {
+		names = append(names, singular+"-list")
+	}
+
+	var items []*xmlNode
+	found := false
+	for _, child := range n.children {
+		if !containsString(names, child.name) {
+			continue
+		}
+		found = true
+		for _, item := range child.children {
+			for key, value := range child.attrs {
+				if _, ok := item.attrs[key]; !ok && key != "count" && key != "offset" {
+					item.attrs[key] = value
+				}
+			}
+			items = append(items, item)
+		}
+	}
+	return items, found
+}
+
+// singulars returns the possible singular forms of a plural field name
+func singulars(name string) []string {
+	switch {
+	case name == "media":
+		return []string{"medium"}
+	case strings.HasSuffix(name, "ies"):
+		return []string{strings.TrimSuffix(name, "ies") + "y"}
+	case strings.HasSuffix(name, "es"):
+		return []string{strings.TrimSuffix(name, "s"), strings.TrimSuffix(name, "es")}
+	case strings.HasSuffix(name, "s"):
+		return []string{strings.TrimSuffix(name, "s")}
+	}
+	return nil
+}
+
+// hasJSONFields reports whether t is a struct with fields named by JSON
+// tags, as opposed to types such as time.Time
+func hasJSONFields(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ","); name != "" && name != "-" {
+			return true
+		}
+	}
+	return false
+}
+
+// scalar returns the JSON value of text decoding into type t: a number for
+// numeric types, a boolean for booleans and a string otherwise. Malformed
+// numbers are dropped.
+func scalar(text string, t reflect.Type) any {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if _, err := strconv.ParseInt(text, 10, 64); err != nil {
+			return nil
+		}
+		return json.Number(text)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if _, err := strconv.ParseUint(text, 10, 64); err != nil {
+			return nil
+		}
+		return json.Number(text)
+	case reflect.Float32, reflect.Float64:
+		if _, err := strconv.ParseFloat(text, 64); err != nil {
+			return nil
+		}
+		return json.Number(text)
+	case reflect.Bool:
+		// Flags such as the primary attribute of aliases hold their own name
+		return text != "" && text != "false"
+	}
+	return text
+}
@@ -0,0 +1,236 @@
+package musicbrainz
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// XML documents as returned by WS/2, trimmed to a few items
+
+const xmlArtistLookup = `<?xml version="1.0" encoding="UTF-8"?>
+<metadata xmlns="http://musicbrainz.org/ns/mmd-2.0#"><artist id="5b11f4ce-a62d-471e-81fc-a69a8278c7da" type="Group" type-id="e431f5f6-b5d2-343d-8b36-72607fffb74b"><name>Nirvana</name><sort-name>Nirvana</sort-name><disambiguation>90s US grunge band</disambiguation><country>US</country><area id="489ce91b-6658-3307-9877-795b68554c98"><name>United States</name><sort-name>United States</sort-name><iso-3166-1-code-list><iso-3166-1-code>US</iso-3166-1-code></iso-3166-1-code-list></area><begin-area id="a640b45c-c173-49b1-8030-973603e895b5"><name>Aberdeen</name><sort-name>Aberdeen</sort-name></begin-area><life-span><begin>1987</begin><end>1994-04-05</end><ended>true</ended></life-span><alias-list count="1"><alias sort-name="Nirvana US" type="Search hint" type-id="1937e404-b981-3cb7-8151-4c86ebfc8d8e">Nirvana US</alias></alias-list><relation-list target-type="url"><relation type="discogs" type-id="04a5b104-a4c2-4bac-99a1-7b837c37d9e4"><target id="4a425cd3-641d-409c-a282-2334935bf1bd">https://www.discogs.com/artist/125246</target></relation></relation-list><relation-list target-type="artist"><relation type="member of band" type-id="5be4c609-9afa-4ea0-910b-12ffb71e3821"><target>5b11f4ce-a62d-471e-81fc-a69a8278c7db</target><direction>backward</direction><attribute-list><attribute>original</attribute></attribute-list><begin>1987</begin><end>1994-04-05</end><ended>true</ended><artist id="5b11f4ce-a62d-471e-81fc-a69a8278c7db" type="Person" type-id="b6e035f4-3ce9-331c-97df-83397230b0df"><name>Kurt Cobain</name><sort-name>Cobain, Kurt</sort-name></artist></relation></relation-list><tag-list><tag count="12"><name>grunge</name></tag></tag-list><rating votes-count="9">4.65</rating></artist></metadata>`
+
+const xmlReleaseLookup = `<?xml version="1.0" encoding="UTF-8"?>
+<metadata xmlns="http://musicbrainz.org/ns/mmd-2.0#"><release id="b84ee12a-09ef-421b-82de-0441a926375b"><title>Nevermind</title><status id="4e304316-386d-3409-af2e-78857eec5cfe">Official</status><quality>normal</quality><packaging id="ec27701a-4a22-37f4-bfac-6616e0f9750a">Jewel Case</packaging><text-representation><language>eng</language><script>Latn</script></text-representation><artist-credit><name-credit><artist id="5b11f4ce-a62d-471e-81fc-a69a8278c7da" type="Group" type-id="e431f5f6-b5d2-343d-8b36-72607fffb74b"><name>Nirvana</name><sort-name>Nirvana</sort-name></artist></name-credit></artist-credit><release-group id="1b022e01-4da6-387b-8658-8678046e4cef" type="Album" type-id="f529b476-6e62-324f-b0aa-1f3e33d313fc"><title>Nevermind</title><first-release-date>1991-09-24</first-release-date><primary-type id="f529b476-6e62-324f-b0aa-1f3e33d313fc">Album</primary-type></release-group><date>1991-09-24</date><country>US</country><release-event-list count="1"><release-event><date>1991-09-24</date><area id="489ce91b-6658-3307-9877-795b68554c98"><name>United States</name><sort-name>United States</sort-name><iso-3166-1-code-list><iso-3166-1-code>US</iso-3166-1-code></iso-3166-1-code-list></area></release-event></release-event-list><barcode>720642442524</barcode><asin>B000003TA4</asin><cover-art-archive><artwork>true</artwork><count>5</count><front>true</front><back>true</back><darkened>false</darkened></cover-art-archive><label-info-list count="1"><label-info><catalog-number>DGCD-24425</catalog-number><label id="f7e4a7d5-8e16-4a33-9d3a-0a2a4c5b8b31"><name>DGC</name><sort-name>DGC</sort-name><label-code>7266</label-code></label></label-info></label-info-list><medium-list count="1"><medium><position>1</position><format id="9712d52a-4509-3d4b-a1a2-67c88c643e31">CD</format><disc-list count="1"><disc id="8jJklE8RqVoSf7ha2eB8W3ZrAAg-"><sectors>220812</sectors><offset-list count="2"><offset position="1">150</offset><offset position="2">22767</offset></offset-list></disc></disc-list><track-list count="2" offset="0"><track id="6b8a6d0a-8ee9-3e7f-b6f6-0a0d3a4f5a11"><position>1</position><number>1</number><length>301920</length><recording id="5fb524f1-8cc8-4c04-a921-e34c0a911ea7"><title>Smells Like Teen Spirit</title><length>301920</length><first-release-date>1991-09-10</first-release-date><isrc-list count="1"><isrc id="USGF19942501"></isrc></isrc-list></recording></track><track id="7c9b7e1b-9ff0-4f80-c7f7-1b1e4b5a6b22"><position>2</position><number>2</number><length>254800</length><recording id="4b0e6b8d-2a3a-4ac2-8a65-8a4e1bdfdd4b"><title>In Bloom</title><length>254800</length></recording></track></track-list></medium></medium-list></release></metadata>`
+
+const xmlRecordingSearch = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<metadata created="2024-03-01T12:00:00.000Z" xmlns="http://musicbrainz.org/ns/mmd-2.0#" xmlns:ns2="http://musicbrainz.org/ns/ext#-2.0"><recording-list count="412" offset="25"><recording id="5fb524f1-8cc8-4c04-a921-e34c0a911ea7" ns2:score="100"><title>Smells Like Teen Spirit</title><length>301920</length><artist-credit><name-credit joinphrase=" &amp; "><name>Nirvana</name><artist id="5b11f4ce-a62d-471e-81fc-a69a8278c7da"><name>Nirvana</name><sort-name>Nirvana</sort-name></artist></name-credit><name-credit><name>Guest</name><artist id="5b11f4ce-a62d-471e-81fc-a69a8278c7db"><name>Kurt Cobain</name><sort-name>Cobain, Kurt</sort-name></artist></name-credit></artist-credit><release-list><release id="b84ee12a-09ef-421b-82de-0441a926375b"><title>Nevermind</title><status id="4e304316-386d-3409-af2e-78857eec5cfe">Official</status><release-group id="1b022e01-4da6-387b-8658-8678046e4cef" type="Album" type-id="f529b476-6e62-324f-b0aa-1f3e33d313fc"><title>Nevermind</title><primary-type id="f529b476-6e62-324f-b0aa-1f3e33d313fc">Album</primary-type><secondary-type-list><secondary-type id="6fd474e2-6b58-3102-9d17-d6f7eb7da0a0">Live</secondary-type></secondary-type-list></release-group><country>US</country><date>1991-09-24</date></release></release-list></recording></recording-list></metadata>`
+
+const xmlReleaseBrowse = `<?xml version="1.0" encoding="UTF-8"?>
+<metadata xmlns="http://musicbrainz.org/ns/mmd-2.0#"><release-list count="188" offset="100"><release id="b84ee12a-09ef-421b-82de-0441a926375b"><title>Nevermind</title><status id="4e304316-386d-3409-af2e-78857eec5cfe">Official</status><date>1991-09-24</date><country>US</country></release></release-list></metadata>`
+
+const xmlISRCLookup = `<?xml version="1.0" encoding="UTF-8"?>
+<metadata xmlns="http://musicbrainz.org/ns/mmd-2.0#"><isrc id="USGF19942501"><recording-list count="1"><recording id="5fb524f1-8cc8-4c04-a921-e34c0a911ea7"><title>Smells Like Teen Spirit</title><length>301920</length></recording></recording-list></isrc></metadata>`
+
+const xmlNotFound = `<?xml version="1.0" encoding="UTF-8"?>
+<error><text>Not Found</text><text>For usage, please see: https://musicbrainz.org/development/mmd</text></error>`
+
+// newXMLClient returns a client requesting XML from a server answering with
+// the document of each path
+func newXMLClient(t *testing.T, documents map[string]string) *Client {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("fmt"); got != "xml" {
+			t.Errorf("%s: fmt=%q, want xml", r.URL, got)
+		}
+		w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+		document, ok := documents[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			document = xmlNotFound
+		}
+		w.Write([]byte(document))
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := NewClient(WithBaseURL(server.URL), WithoutRateLimit(), WithRetry(1, 0, 0), WithFormat(FormatXML))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestXMLArtistLookup(t *testing.T) {
+	c := newXMLClient(t, map[string]string{"/ws/2/artist/5b11f4ce-a62d-471e-81fc-a69a8278c7da": xmlArtistLookup})
+	artist, err := c.GetArtistByID(context.Background(), "5b11f4ce-a62d-471e-81fc-a69a8278c7da")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if artist.Name != "Nirvana" || artist.SortName != "Nirvana" || artist.Type != "Group" || artist.Disambig != "90s US grunge band" {
+		t.Errorf("got artist %+v", artist)
+	}
+	if artist.Area == nil || artist.Area.Name != "United States" || !reflect.DeepEqual(artist.Area.ISO31661Codes, []string{"US"}) {
+		t.Errorf("got area %+v", artist.Area)
+	}
+	if artist.BeginArea == nil || artist.BeginArea.Name != "Aberdeen" {
+		t.Errorf("got begin area %+v", artist.BeginArea)
+	}
+	if artist.LifeSpan.Begin.String() != "1987" || artist.LifeSpan.End.String() != "1994-04-05" || !artist.LifeSpan.Ended {
+		t.Errorf("got life span %+v", artist.LifeSpan)
+	}
+	if len(artist.Aliases) != 1 || artist.Aliases[0].Name != "Nirvana US" || artist.Aliases[0].Type != "Search hint" {
+		t.Errorf("got aliases %+v", artist.Aliases)
+	}
+	if len(artist.Tags) != 1 || artist.Tags[0] != (Tag{Name: "grunge", Count: 12}) {
+		t.Errorf("got tags %+v", artist.Tags)
+	}
+	if artist.Rating == nil || artist.Rating.VotesCount != 9 || artist.Rating.Value != 4.65 {
+		t.Errorf("got rating %+v", artist.Rating)
+	}
+
+	if len(artist.Relations) != 2 {
+		t.Fatalf("got %d relations, want 2", len(artist.Relations))
+	}
+	discogs := artist.Relations[0]
+	if discogs.Type != "discogs" || discogs.TargetType != "url" || discogs.Direction != "forward" ||
+		discogs.URL == nil || discogs.URL.Resource != "https://www.discogs.com/artist/125246" || discogs.URL.ID != "4a425cd3-641d-409c-a282-2334935bf1bd" {
+		t.Errorf("got url relation %+v, url %+v", discogs, discogs.URL)
+	}
+	member := artist.Relations[1]
+	if member.Type != "member of band" || member.TargetType != "artist" || !member.Backward() ||
+		!reflect.DeepEqual(member.Attributes, []string{"original"}) || !member.Ended ||
+		member.Artist == nil || member.Artist.Name != "Kurt Cobain" {
+		t.Errorf("got artist relation %+v, artist %+v", member, member.Artist)
+	}
+}
+
+func TestXMLReleaseLookup(t *testing.T) {
+	c := newXMLClient(t, map[string]string{"/ws/2/release/b84ee12a-09ef-421b-82de-0441a926375b": xmlReleaseLookup})
+	release, err := c.GetReleaseByID(context.Background(), "b84ee12a-09ef-421b-82de-0441a926375b", WithIncludes("recordings", "artist-credits", "labels", "discids", "isrcs"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if release.Title != "Nevermind" || release.Status != "Official" || release.Date.String() != "1991-09-24" ||
+		release.Barcode != "720642442524" || release.TextRepresentation.Script != "Latn" {
+		t.Errorf("got release %+v", release)
+	}
+	if got := release.ArtistCredit.String(); got != "Nirvana" {
+		t.Errorf("got artist credit %q", got)
+	}
+	if release.ArtistCredit[0].Name != "Nirvana" {
+		t.Errorf("got credited name %q, want the artist name", release.ArtistCredit[0].Name)
+	}
+	if release.ReleaseGroup.PrimaryType != ReleaseGroupTypeAlbum || release.ReleaseGroup.FirstReleaseDate.String() != "1991-09-24" {
+		t.Errorf("got release group %+v", release.ReleaseGroup)
+	}
+	if len(release.ReleaseEvents) != 1 || release.ReleaseEvents[0].Area == nil || release.ReleaseEvents[0].Area.Name != "United States" {
+		t.Errorf("got release events %+v", release.ReleaseEvents)
+	}
+	if !release.CoverArtArchive.Artwork || release.CoverArtArchive.Count != 5 || !release.CoverArtArchive.Back {
+		t.Errorf("got cover art archive %+v", release.CoverArtArchive)
+	}
+	if len(release.LabelInfo) != 1 || release.LabelInfo[0].CatalogNumber != "DGCD-24425" || release.LabelInfo[0].Label == nil || release.LabelInfo[0].Label.Name != "DGC" {
+		t.Errorf("got label info %+v", release.LabelInfo)
+	}
+
+	if len(release.Media) != 1 {
+		t.Fatalf("got %d media, want 1", len(release.Media))
+	}
+	medium := release.Media[0]
+	if medium.Format != "CD" || medium.TrackCount != 2 || medium.DiscCount != 1 {
+		t.Errorf("got medium %+v", medium)
+	}
+	if len(medium.Discs) != 1 || medium.Discs[0].Sectors != 220812 || !reflect.DeepEqual(medium.Discs[0].Offsets, []int{150, 22767}) {
+		t.Errorf("got discs %+v", medium.Discs)
+	}
+	if len(medium.Tracks) != 2 {
+		t.Fatalf("got %d tracks, want 2", len(medium.Tracks))
+	}
+	track := medium.Tracks[0]
+	if track.Position != 1 || track.Number != "1" || track.Length.Std() != 301920*time.Millisecond {
+		t.Errorf("got track %+v", track)
+	}
+	if track.Recording.Title != "Smells Like Teen Spirit" || track.Recording.ReleaseDate.String() != "1991-09-10" ||
+		!reflect.DeepEqual(track.Recording.ISRCs, []string{"USGF19942501"}) {
+		t.Errorf("got recording %+v", track.Recording)
+	}
+}
+
+func TestXMLSearch(t *testing.T) {
+	c := newXMLClient(t, map[string]string{"/ws/2/recording/": xmlRecordingSearch})
+	result, err := c.SearchRecordings(context.Background(), "smells like teen spirit", 25, WithOffset(25))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Count != 412 || result.Offset != 25 || !result.Created.Equal(time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("got result %+v", result)
+	}
+	if len(result.Recordings) != 1 {
+		t.Fatalf("got %d recordings, want 1", len(result.Recordings))
+	}
+	recording := result.Recordings[0]
+	if recording.Score != 100 {
+		t.Errorf("got score %d, want 100", recording.Score)
+	}
+	if got := recording.ArtistCredit.String(); got != "Nirvana & Guest" {
+		t.Errorf("got artist credit %q", got)
+	}
+	if len(recording.Releases) != 1 || !recording.Releases[0].ReleaseGroup.HasSecondaryType(ReleaseGroupSecondaryTypeLive) {
+		t.Errorf("got releases %+v", recording.Releases)
+	}
+}
+
+func TestXMLBrowse(t *testing.T) {
+	c := newXMLClient(t, map[string]string{"/ws/2/release": xmlReleaseBrowse})
+	result, err := c.BrowseReleasesByArtist(context.Background(), "5b11f4ce-a62d-471e-81fc-a69a8278c7da", WithOffset(100))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Count != 188 || result.Offset != 100 || len(result.Releases) != 1 || result.Releases[0].Title != "Nevermind" {
+		t.Errorf("got result %+v", result)
+	}
+}
+
+func TestXMLISRCLookup(t *testing.T) {
+	c := newXMLClient(t, map[string]string{"/ws/2/isrc/USGF19942501": xmlISRCLookup})
+	recordings, err := c.GetRecordingsByISRC(context.Background(), "USGF19942501")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(recordings) != 1 || recordings[0].Title != "Smells Like Teen Spirit" {
+		t.Errorf("got recordings %+v", recordings)
+	}
+}
+
+func TestXMLError(t *testing.T) {
+	c := newXMLClient(t, nil)
+	_, err := c.GetArtistByID(context.Background(), "5b11f4ce-a62d-471e-81fc-a69a8278c7da")
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("got %v, want ErrNotFound", err)
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Message != "Not Found" || apiErr.Help == "" {
+		t.Errorf("got %#v", apiErr)
+	}
+}
+
+func TestXMLStrictDecodingRejected(t *testing.T) {
+	if _, err := NewClient(WithFormat(FormatXML), WithStrictDecoding()); err == nil {
+		t.Error("strict decoding accepted with the XML format")
+	}
+}
+
+func TestIsXML(t *testing.T) {
+	tests := []struct {
+		rawURL string
+		want   bool
+	}{
+		{"https://musicbrainz.org/ws/2/artist/?fmt=xml&query=nirvana", true},
+		{"https://musicbrainz.org/ws/2/artist/?fmt=json&query=nirvana", false},
+		{"https://musicbrainz.org/ws/2/artist/?fmt=json&query=fmt%3Dxml", false},
+		{"https://musicbrainz.org/ws/2/artist/?fmt=json&query=afmt=xml", false},
+	}
+	for _, tt := range tests {
+		if got := isXML(tt.rawURL); got != tt.want {
+			t.Errorf("isXML(%q) = %v, want %v", tt.rawURL, got, tt.want)
+		}
+	}
+}